@@ -3,75 +3,92 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"time"
 
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/output"
+	"github.com/kso1204/gitday/internal/period"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
-	"github.com/kso1204/gitday/internal/git"
-	"github.com/kso1204/gitday/internal/output"
 )
 
 var exportCmd = &cobra.Command{
 	Use:   "export",
-	Short: "리포트를 마크다운으로 출력/저장",
+	Short: "리포트를 마크다운/JSON/HTML/CSV로 출력/저장",
 	RunE:  runExport,
 }
 
 func init() {
 	exportCmd.Flags().StringP("output", "o", "", "출력 파일 경로 (미지정 시 stdout)")
-	exportCmd.Flags().String("period", "today", "기간: today, week")
+	exportCmd.Flags().String("format", "", "출력 형식: markdown, json, ndjson, html, csv (기본: markdown)")
+	exportCmd.Flags().String("layout", "", "CSV 레이아웃: timesheet (레포×날짜별), commits (커밋별) (기본: timesheet)")
 	rootCmd.AddCommand(exportCmd)
 }
 
+// exportFormats는 export가 지원하는 출력 형식이다. 첫 번째가 기본값이다.
+var exportFormats = []string{output.FormatMarkdown, output.FormatJSON, output.FormatNDJSON, output.FormatHTML, output.FormatCSV}
+
 func runExport(cmd *cobra.Command, args []string) error {
-	period, _ := cmd.Flags().GetString("period")
 	outputPath, _ := cmd.Flags().GetString("output")
-
-	now := time.Now()
-	var since time.Time
-
-	switch period {
-	case "week":
-		weekday := int(now.Weekday())
-		if weekday == 0 {
-			weekday = 7
-		}
-		monday := now.AddDate(0, 0, -(weekday - 1))
-		since = time.Date(monday.Year(), monday.Month(), monday.Day(), 0, 0, 0, 0, now.Location())
-	default:
-		since = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	format, err := formatFlag(cmd, exportFormats...)
+	if err != nil {
+		return err
 	}
 
-	scanPaths := viper.GetStringSlice("scan_paths")
-	excludes := viper.GetStringSlice("exclude")
-	author := viper.GetString("author")
+	rng, err := resolveRange(cmd, period.Today)
+	if err != nil {
+		return err
+	}
+	tmpl, err := userTemplate(cmd)
+	if err != nil {
+		return err
+	}
 
-	repos, err := git.ScanRepos(scanPaths, excludes)
+	repos, err := scanRepos()
 	if err != nil {
-		return fmt.Errorf("레포 스캔 실패: %w", err)
+		return errorf("cmd.scan_failed", err)
 	}
 
-	results, err := git.CollectLogs(repos, since, now, author)
+	results, failed, err := collectReportLogs(repos, rng.Since, rng.Until, format, tmpl)
 	if err != nil {
-		return fmt.Errorf("커밋 로그 수집 실패: %w", err)
+		return err
+	}
+	output.PrintWarnings(failed)
+	if err := strictError(failed); err != nil {
+		return err
 	}
 
-	if len(results) == 0 {
-		fmt.Println("내보낼 커밋이 없습니다.")
+	if len(results) == 0 && !output.IsMachineFormat(format) {
+		fmt.Println(i18n.T("cmd.export_empty"))
 		return nil
 	}
 
-	md := output.ToMarkdown(results, since, now, "")
+	summaryText := ""
+	if viper.GetBool("summary") && len(results) > 0 {
+		summaryText = getSummary(results, rng)
+	}
+
+	opts := reportOptions()
+	opts.Layout, _ = cmd.Flags().GetString("layout")
+
+	var doc []byte
+	if tmpl != nil {
+		doc, err = output.RenderTemplate(tmpl, results, rng, summaryText)
+	} else {
+		doc, err = output.Render(format, results, rng, summaryText, opts)
+	}
+	if err != nil {
+		return err
+	}
 
 	if outputPath == "" {
-		fmt.Print(md)
+		os.Stdout.Write(doc)
 		return nil
 	}
 
-	if err := os.WriteFile(outputPath, []byte(md), 0644); err != nil {
-		return fmt.Errorf("파일 저장 실패: %w", err)
+	if err := os.WriteFile(outputPath, doc, 0644); err != nil {
+		return errorf("cmd.write_failed", err)
 	}
 
-	fmt.Printf("✓ 리포트 저장됨: %s\n", outputPath)
+	fmt.Println(i18n.T("cmd.export_saved", outputPath))
 	return nil
 }
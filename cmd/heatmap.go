@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/output"
+	"github.com/kso1204/gitday/internal/stats"
+	"github.com/spf13/cobra"
+)
+
+var heatmapCmd = &cobra.Command{
+	Use:   "heatmap",
+	Short: "최근 N개월 커밋 잔디(히트맵)",
+	Long: `스캔한 모든 레포의 커밋을 주 × 요일 격자로 보여준다 (GitHub 잔디와 같은 형식).
+--repo로 레포를, --author로 작성자를 좁힐 수 있고, --format svg로 SVG 파일을 만들 수 있다.`,
+	RunE: runHeatmap,
+}
+
+func init() {
+	heatmapCmd.Flags().Int("months", 6, "최근 몇 개월을 보여줄지 (--period/--since가 있으면 무시)")
+	heatmapCmd.Flags().StringSlice("repo", nil, "레포 이름 필터 (여러 번 지정 가능)")
+	heatmapCmd.Flags().String("format", "", "출력 형식: text, svg (기본: text)")
+	heatmapCmd.Flags().StringP("output", "o", "", "출력 파일 경로 (미지정 시 stdout)")
+	rootCmd.AddCommand(heatmapCmd)
+}
+
+// heatmapFormats는 heatmap이 지원하는 출력 형식이다. 첫 번째가 기본값이다.
+var heatmapFormats = []string{output.FormatText, output.FormatSVG}
+
+func runHeatmap(cmd *cobra.Command, args []string) error {
+	format, err := formatFlag(cmd, heatmapFormats...)
+	if err != nil {
+		return err
+	}
+	months, _ := cmd.Flags().GetInt("months")
+	repoNames, _ := cmd.Flags().GetStringSlice("repo")
+	outputPath, _ := cmd.Flags().GetString("output")
+
+	rng, err := resolveRange(cmd, fmt.Sprintf("last-%dm", months))
+	if err != nil {
+		return err
+	}
+
+	repos, err := scanRepos()
+	if err != nil {
+		return errorf("cmd.scan_failed", err)
+	}
+	if len(repoNames) > 0 {
+		repos = filterRepos(repos, repoNames)
+		if len(repos) == 0 {
+			return errorf("heatmap.no_repo", strings.Join(repoNames, ", "))
+		}
+	}
+
+	results, failed, err := collectLogs(repos, rng.Since, rng.Until)
+	if err != nil {
+		return err
+	}
+
+	h := stats.NewHeatmap(results, rng)
+	if format == output.FormatSVG {
+		doc := output.HeatmapSVG(h, rng)
+		if outputPath == "" {
+			os.Stdout.Write(doc)
+		} else if err := os.WriteFile(outputPath, doc, 0644); err != nil {
+			return errorf("cmd.write_failed", err)
+		} else {
+			fmt.Println(i18n.T("cmd.export_saved", outputPath))
+		}
+	} else {
+		output.PrintHeatmap(h, rng, output.TerminalWidth())
+	}
+
+	output.PrintWarnings(failed)
+	return strictError(failed)
+}
+
+// filterRepos는 이름(대소문자 무시)이 names 중 하나와 같은 레포만 남긴다.
+func filterRepos(repos []git.Repo, names []string) []git.Repo {
+	var filtered []git.Repo
+	for _, r := range repos {
+		for _, name := range names {
+			if strings.EqualFold(r.Name(), name) {
+				filtered = append(filtered, r)
+				break
+			}
+		}
+	}
+	return filtered
+}
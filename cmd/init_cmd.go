@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/kso1204/gitday/internal/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -25,15 +26,42 @@ const defaultConfig = `# gitday 설정 파일
 scan_paths:
   - ~/Documents/home
 
-# 제외 패턴
+# 탐색 깊이 (1 = scan_path/project/.git, 2 = scan_path/client/project/.git ...)
+scan_depth: 1
+
+# 레포 안쪽의 중첩 레포까지 탐색할지 여부
+scan_nested: false
+
+# 제외 패턴 (모든 깊이에 적용)
 exclude:
   - node_modules
   - vendor
   - .cache
   - .venv
 
-# Git 저자 (비워두면 git config user.name 사용)
-author: ""
+# 출력 언어: ko | en (터미널/마크다운 라벨, 요일, AI 요약 언어)
+language: ko
+
+# 타임존 (IANA 이름, 비워두면 시스템 타임존)
+timezone: ""
+
+# 하루 시작 시각. "04:00"이면 새벽 4시 전 커밋은 전날로 집계
+day_start: "00:00"
+
+# 작성자 필터: 이름이나 이메일 일부 (대소문자 무시, Co-authored-by 공동 작성자 포함)
+# 각 레포의 .mailmap을 적용한 이름/이메일로도 비교한다.
+# 비워두면 레포마다 git config user.email, "*"이면 전체
+author: []
+# author:
+#   - me@company.com
+#   - me@gmail.com
+#   - 12345+me@users.noreply.github.com
+
+# 이 브랜치의 커밋만 집계 (glob, --branch). 비워두면 모든 ref (git log --all)
+branches: []
+
+# 병합 커밋: collapse (커밋 수에서 빼고 "병합 PR #12" 한 줄) | hide (--no-merges) | show
+merges: collapse
 
 # AI 설정
 ai:
@@ -42,40 +70,79 @@ ai:
   model: ""         # 비워두면 기본값 사용
   ollama_url: "http://localhost:11434"
 
+# 스탠드업 (gitday standup)
+standup:
+  work_days: [mon, tue, wed, thu, fri]
+  holidays: []      # 예: ["2026-10-09", "2026-12-25"]
+
+# 팀 리포트 (gitday team). 별칭은 이름이나 이메일 전체 (author와 달리 일부만 같으면 안 됨)
+# 비워두면 .mailmap을 적용한 작성자 이름별로 나눈다
+team: []
+# team:
+#   - name: Wook Kim
+#     aliases: [wook@work.com, wook@gmail.com, 12345+wook@users.noreply.github.com]
+#   - name: Lee Jin
+#     aliases: [lee@example.com]
+
+# 이슈 키 (--group-by ticket, AI 요약). 커밋 메시지와 그 커밋을 작성한 브랜치 이름에서 찾는다
+# 캡처 그룹이 있으면 첫 번째 그룹을 키로 쓴다
+tickets:
+  patterns:
+    - '\b[A-Z][A-Z0-9]*[A-Z][A-Z0-9]*-[0-9]+\b'  # Jira: PAY-1234
+    - '(?:^|[^(\w])(#[0-9]+)\b'                # GitHub/GitLab: #87 (squash 병합의 "(#123)"은 제외)
+  # 키로 보지 않을 값 (접두어가 아니라 전체가 같아야 한다)
+  ignore: [UTF-8, UTF-16, UTF-32, SHA-1, SHA-224, SHA-256, SHA-384, SHA-512, SHA3-256, SHA3-512, MD5, ISO-8601, ISO-8859, ISO-639, ISO-3166]
+
+# 타임시트 (export --format csv) 작업 시간 추정
+timesheet:
+  max_gap: 2h        # 이보다 긴 커밋 간격은 새 작업 세션
+  first_commit: 30m  # 세션 첫 커밋 전 작업 시간
+
+# 사용자 템플릿 (--template 이름)
+templates: {}
+#  standup: ~/.gitday/templates/standup.tmpl
+
 # Slack
 slack:
   webhook_url: ""
 
 # 출력
 output:
-  color: true
+  color: true      # false면 항상 평문. NO_COLOR, --no-color, 파이프/파일 출력도 평문
   compact: false
+  files: false     # 커밋별 변경 파일 목록 (--files)
+  stats: false     # 시간대/요일별 활동 통계 (--stats)
+  width: 0         # 터미널 너비 (0 = 자동 감지, 파이프/파일은 COLUMNS 또는 80)
+  overflow: wrap   # 긴 커밋 메시지: wrap (줄바꿈) | truncate (…로 자르기)
+  sort: name       # 레포 정렬: name | commits | churn | recent
+  commit_order: desc  # 커밋 정렬: desc | asc
+  group_by: repo   # 커밋 묶음: repo | type | scope (Conventional Commits) | ticket (이슈 키) | branch (작성 브랜치)
 `
 
 func runInit(cmd *cobra.Command, args []string) error {
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return fmt.Errorf("홈 디렉토리 확인 실패: %w", err)
+		return errorf("cmd.home_failed", err)
 	}
 
 	configPath := filepath.Join(home, ".gitday.yaml")
 
 	if _, err := os.Stat(configPath); err == nil {
-		fmt.Printf("⚠ 이미 설정 파일이 존재합니다: %s\n", configPath)
-		fmt.Print("덮어쓰시겠습니까? (y/N): ")
+		fmt.Println(i18n.T("cmd.init_exists", configPath))
+		fmt.Print(i18n.T("cmd.init_overwrite"))
 		var answer string
 		fmt.Scanln(&answer)
 		if answer != "y" && answer != "Y" {
-			fmt.Println("취소되었습니다.")
+			fmt.Println(i18n.T("cmd.init_cancelled"))
 			return nil
 		}
 	}
 
 	if err := os.WriteFile(configPath, []byte(defaultConfig), 0600); err != nil {
-		return fmt.Errorf("설정 파일 생성 실패: %w", err)
+		return errorf("cmd.init_failed", err)
 	}
 
-	fmt.Printf("✓ 설정 파일 생성됨: %s\n", configPath)
-	fmt.Println("  scan_paths를 수정하여 스캔할 디렉토리를 지정하세요.")
+	fmt.Println(i18n.T("cmd.init_created", configPath))
+	fmt.Println(i18n.T("cmd.init_hint"))
 	return nil
 }
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -18,6 +21,17 @@ var rootCmd = &cobra.Command{
 	Short:   "Git 데일리 활동 요약 도구",
 	Long:    `멀티레포 Git 로그를 스캔해서 오늘의 작업 내용을 보여주고, AI로 자연어 요약하는 CLI 도구.`,
 	Version: version,
+	// 실행 중 에러(--strict 등)마다 사용법이 출력되지 않도록 한다
+	SilenceUsage: true,
+	// 설정 파일을 읽은 뒤 출력 언어와 색상 사용 여부를 정한다
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := i18n.SetLanguage(viper.GetString("language")); err != nil {
+			return errorf("cmd.config_error", err)
+		}
+		noColor, _ := cmd.Flags().GetBool("no-color")
+		output.SetColor(viper.GetBool("output.color") && !noColor)
+		return nil
+	},
 }
 
 func Execute() {
@@ -34,14 +48,42 @@ func init() {
 		return runToday(cmd, args)
 	}
 
+	// 기본 동작이 today이므로 today와 같은 로컬 플래그를 둔다
+	rootCmd.Flags().String("format", "", "출력 형식: text, markdown, json, ndjson (기본: text)")
+
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "설정 파일 경로 (기본: ~/.gitday.yaml)")
-	rootCmd.PersistentFlags().String("author", "", "Git 저자 필터")
+	rootCmd.PersistentFlags().StringSlice("author", nil, "작성자 필터: 이름/이메일 (여러 번 지정 가능, 기본: 레포의 user.email, \"*\"는 전체)")
 	rootCmd.PersistentFlags().Bool("summary", false, "AI 요약 포함")
 	rootCmd.PersistentFlags().Bool("compact", false, "간략 출력 모드")
+	rootCmd.PersistentFlags().String("period", "", "기간: today, yesterday, week, month, last-7d, \"3 days ago\"")
+	rootCmd.PersistentFlags().String("since", "", "시작 시각 (2026-10-01, \"3 days ago\", yesterday)")
+	rootCmd.PersistentFlags().String("until", "", "종료 시각 (날짜만 주면 그날 끝까지 포함)")
+	rootCmd.PersistentFlags().Bool("strict", false, "로그 수집에 실패한 레포가 있으면 에러로 종료")
+	rootCmd.PersistentFlags().Int("depth", 0, "레포 탐색 깊이 (기본: scan_depth 설정, 1)")
+	rootCmd.PersistentFlags().String("sort", "", "레포 정렬: name, commits, churn, recent (기본: name)")
+	rootCmd.PersistentFlags().String("commit-order", "", "커밋 정렬: desc, asc (기본: desc)")
+	rootCmd.PersistentFlags().String("group-by", "", "커밋 묶음: repo, type, scope, ticket, branch (기본: repo)")
+	rootCmd.PersistentFlags().StringSlice("branch", nil, "이 브랜치의 커밋만 (glob, 여러 번 지정 가능. 예: main, feature/*)")
+	rootCmd.PersistentFlags().Bool("no-merges", false, "병합 커밋 제외 (merges: hide와 같음)")
+	rootCmd.PersistentFlags().Bool("files", false, "커밋별 변경 파일 목록 출력")
+	rootCmd.PersistentFlags().Bool("stats", false, "시간대/요일별 활동 통계 섹션 추가")
+	rootCmd.PersistentFlags().Bool("no-color", false, "색상 없이 출력 (NO_COLOR 환경변수, 파이프 출력도 같음)")
+	rootCmd.PersistentFlags().String("lang", "", "출력 언어: ko, en (기본: language 설정, ko)")
+	rootCmd.PersistentFlags().String("template", "", "사용자 템플릿 파일 경로 또는 templates 설정의 이름")
 
 	viper.BindPFlag("author", rootCmd.PersistentFlags().Lookup("author"))
 	viper.BindPFlag("output.compact", rootCmd.PersistentFlags().Lookup("compact"))
+	viper.BindPFlag("strict", rootCmd.PersistentFlags().Lookup("strict"))
+	viper.BindPFlag("scan_depth", rootCmd.PersistentFlags().Lookup("depth"))
+	viper.BindPFlag("output.sort", rootCmd.PersistentFlags().Lookup("sort"))
+	viper.BindPFlag("output.commit_order", rootCmd.PersistentFlags().Lookup("commit-order"))
+	viper.BindPFlag("output.group_by", rootCmd.PersistentFlags().Lookup("group-by"))
+	viper.BindPFlag("branches", rootCmd.PersistentFlags().Lookup("branch"))
+	viper.BindPFlag("output.files", rootCmd.PersistentFlags().Lookup("files"))
+	viper.BindPFlag("output.stats", rootCmd.PersistentFlags().Lookup("stats"))
 	viper.BindPFlag("summary", rootCmd.PersistentFlags().Lookup("summary"))
+	viper.BindPFlag("language", rootCmd.PersistentFlags().Lookup("lang"))
+	viper.BindPFlag("template", rootCmd.PersistentFlags().Lookup("template"))
 }
 
 func initConfig() {
@@ -62,12 +104,34 @@ func initConfig() {
 	viper.AutomaticEnv()
 
 	// 기본값
+	viper.SetDefault("language", i18n.DefaultLanguage)
 	viper.SetDefault("scan_paths", []string{"."})
 	viper.SetDefault("exclude", []string{"node_modules", "vendor", ".cache", ".venv"})
+	viper.SetDefault("scan_depth", 1)
+	viper.SetDefault("scan_nested", false)
 	viper.SetDefault("ai.provider", "claude")
 	viper.SetDefault("ai.ollama_url", "http://localhost:11434")
+	viper.SetDefault("standup.work_days", []string{"mon", "tue", "wed", "thu", "fri"})
+	viper.SetDefault("team", []git.Member{})
+	viper.SetDefault("merges", git.MergesCollapse)
+	viper.SetDefault("tickets.patterns", git.DefaultTicketPatterns)
+	viper.SetDefault("tickets.ignore", git.DefaultTicketIgnore)
+	viper.SetDefault("timesheet.max_gap", "2h")
+	viper.SetDefault("timesheet.first_commit", "30m")
 	viper.SetDefault("output.color", true)
 	viper.SetDefault("output.compact", false)
+	viper.SetDefault("output.files", false)
+	viper.SetDefault("output.stats", false)
+	viper.SetDefault("output.width", 0)
+	viper.SetDefault("output.overflow", "wrap")
+	viper.SetDefault("output.sort", "name")
+	viper.SetDefault("output.commit_order", "desc")
+	viper.SetDefault("output.group_by", "repo")
 
 	viper.ReadInConfig()
 }
+
+// errorf는 현재 언어의 메시지(key)로 에러를 만든다. 메시지에 %w가 있으면 args의 에러를 감싼다.
+func errorf(key string, args ...any) error {
+	return i18n.Errorf(key, args...)
+}
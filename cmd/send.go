@@ -2,14 +2,16 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
-	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
 	"github.com/kso1204/gitday/internal/notify"
 	"github.com/kso1204/gitday/internal/output"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var sendCmd = &cobra.Command{
@@ -20,57 +22,45 @@ var sendCmd = &cobra.Command{
 
 func init() {
 	sendCmd.Flags().Bool("slack", false, "Slack 웹훅으로 전송")
-	sendCmd.Flags().String("period", "today", "기간: today, week")
 	rootCmd.AddCommand(sendCmd)
 }
 
 func runSend(cmd *cobra.Command, args []string) error {
 	useSlack, _ := cmd.Flags().GetBool("slack")
 	if !useSlack {
-		return fmt.Errorf("전송 대상을 지정하세요 (예: --slack)")
+		return errors.New(i18n.T("cmd.send_target"))
 	}
 
 	webhookURL := viper.GetString("slack.webhook_url")
 	if webhookURL == "" {
-		return fmt.Errorf("Slack webhook URL이 설정되지 않았습니다. ~/.gitday.yaml에서 설정하세요")
+		return errors.New(i18n.T("cmd.send_webhook"))
 	}
 
-	period, _ := cmd.Flags().GetString("period")
-	now := time.Now()
-	var since time.Time
-
-	switch period {
-	case "week":
-		weekday := int(now.Weekday())
-		if weekday == 0 {
-			weekday = 7
-		}
-		monday := now.AddDate(0, 0, -(weekday - 1))
-		since = time.Date(monday.Year(), monday.Month(), monday.Day(), 0, 0, 0, 0, now.Location())
-	default:
-		since = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	rng, err := resolveRange(cmd, period.Today)
+	if err != nil {
+		return err
 	}
 
-	scanPaths := viper.GetStringSlice("scan_paths")
-	excludes := viper.GetStringSlice("exclude")
-	author := viper.GetString("author")
-
-	repos, err := git.ScanRepos(scanPaths, excludes)
+	repos, err := scanRepos()
 	if err != nil {
-		return fmt.Errorf("레포 스캔 실패: %w", err)
+		return errorf("cmd.scan_failed", err)
 	}
 
-	results, err := git.CollectLogs(repos, since, now, author)
+	results, failed, err := collectLogs(repos, rng.Since, rng.Until)
 	if err != nil {
-		return fmt.Errorf("커밋 로그 수집 실패: %w", err)
+		return err
+	}
+	output.PrintWarnings(failed)
+	if err := strictError(failed); err != nil {
+		return err
 	}
 
 	if len(results) == 0 {
-		fmt.Println("전송할 커밋이 없습니다.")
+		fmt.Println(i18n.T("cmd.send_empty"))
 		return nil
 	}
 
-	md := output.ToMarkdown(results, since, now, "")
+	md := output.ToMarkdown(results, rng, "", reportOptions())
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -79,6 +69,6 @@ func runSend(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Println("✓ Slack 전송 완료!")
+	fmt.Println(i18n.T("cmd.send_done"))
 	return nil
 }
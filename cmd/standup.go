@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kso1204/gitday/internal/ai"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/output"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var standupCmd = &cobra.Command{
+	Use:   "standup",
+	Short: "직전 근무일부터 지금까지의 스탠드업 리포트",
+	Long: `직전 근무일 00:00부터 지금까지의 커밋을 "어제 한 일 / 오늘 할 일 / 블로커" 형식으로 보여준다.
+월요일 아침이면 금요일부터, 휴일 다음 날이면 휴일 전 근무일부터 계산한다.
+근무 요일과 휴일은 ~/.gitday.yaml의 standup.work_days, standup.holidays로 설정한다.`,
+	RunE: runStandup,
+}
+
+func init() {
+	standupCmd.Flags().Bool("markdown", false, "마크다운으로 출력 (Slack/위키 붙여넣기용)")
+	rootCmd.AddCommand(standupCmd)
+}
+
+func runStandup(cmd *cobra.Command, args []string) error {
+	asMarkdown, _ := cmd.Flags().GetBool("markdown")
+	tmpl, err := userTemplate(cmd)
+	if err != nil {
+		return err
+	}
+	if asMarkdown && tmpl != nil {
+		return errors.New(i18n.T("cmd.markdown_template"))
+	}
+
+	cal, err := calendar()
+	if err != nil {
+		return err
+	}
+	workWeek, err := period.NewWorkWeek(
+		cal,
+		viper.GetStringSlice("standup.work_days"),
+		viper.GetStringSlice("standup.holidays"),
+	)
+	if err != nil {
+		return errorf("cmd.standup_config", err)
+	}
+	rng := workWeek.StandupRange(time.Now())
+
+	repos, err := scanRepos()
+	if err != nil {
+		return errorf("cmd.scan_failed", err)
+	}
+
+	results, failed, err := collectReportLogs(repos, rng.Since, rng.Until, "", tmpl)
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		fmt.Println(i18n.T("cmd.no_commits",
+			rng.Since.Format("2006-01-02"),
+			rng.Until.Format("2006-01-02 15:04")))
+		output.PrintWarnings(failed)
+		return strictError(failed)
+	}
+
+	summaryText := ""
+	if viper.GetBool("summary") {
+		summaryText = summarize(ai.BuildStandupPrompt(results, rng.FirstDay().Format("2006-01-02")))
+	}
+
+	if tmpl != nil {
+		doc, err := output.RenderTemplate(tmpl, results, rng, summaryText)
+		if err != nil {
+			return err
+		}
+		os.Stdout.Write(doc)
+	} else if asMarkdown {
+		fmt.Print(output.StandupMarkdown(results, rng, summaryText, reportOptions()))
+	} else {
+		output.PrintStandup(results, rng, summaryText, reportOptions())
+	}
+
+	output.PrintWarnings(failed)
+	return strictError(failed)
+}
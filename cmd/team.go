@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kso1204/gitday/internal/ai"
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/output"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var teamCmd = &cobra.Command{
+	Use:   "team",
+	Short: "팀원별 Git 활동 리포트 (주간 다이제스트)",
+	Long: `작성자 필터 없이 커밋을 모아 사람별로 커밋 수, 변경량, 커밋한 레포를 보여준다.
+Co-authored-by로 공동 작성한 커밋은 작성자와 공동 작성자 모두에게 들어간다.
+~/.gitday.yaml의 team에 팀원 이름과 별칭(이메일, 이름)을 적으면 여러 이메일을 한 사람으로 합치고
+팀원만 섹션으로 보여준다. 설정이 없으면 .mailmap을 적용한 작성자 이름별로 나눈다.
+기본 기간은 이번 주이고, --summary면 사람마다 AI 요약을 만든다.`,
+	RunE: runTeam,
+}
+
+func init() {
+	teamCmd.Flags().String("format", "", "출력 형식: text, markdown (기본: text)")
+	rootCmd.AddCommand(teamCmd)
+}
+
+// teamFormats는 team이 지원하는 출력 형식이다. 첫 번째가 기본값이다.
+var teamFormats = []string{output.FormatText, output.FormatMarkdown}
+
+func runTeam(cmd *cobra.Command, args []string) error {
+	format, err := formatFlag(cmd, teamFormats...)
+	if err != nil {
+		return err
+	}
+
+	var members []git.Member
+	if err := viper.UnmarshalKey("team", &members); err != nil {
+		return errorf("cmd.team_config", err)
+	}
+	team, err := git.NewTeam(members)
+	if err != nil {
+		return errorf("cmd.team_config", err)
+	}
+
+	rng, err := resolveRange(cmd, period.Week)
+	if err != nil {
+		return err
+	}
+
+	repos, err := scanRepos()
+	if err != nil {
+		return errorf("cmd.scan_failed", err)
+	}
+	if len(repos) == 0 {
+		fmt.Println(i18n.T("cmd.no_repos"))
+		return nil
+	}
+
+	opts, err := logOptions()
+	if err != nil {
+		return err
+	}
+	opts.Authors = []string{git.AllAuthors}
+	results, failed, err := collectLogsWith(repos, rng.Since, rng.Until, opts)
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		fmt.Println(i18n.T("cmd.no_commits",
+			rng.Since.Format("2006-01-02"),
+			rng.Until.Format("2006-01-02 15:04")))
+		output.PrintWarnings(failed)
+		return strictError(failed)
+	}
+
+	report := output.TeamReport{Results: results}
+	report.Members, report.Others = team.ByPerson(results)
+	if viper.GetBool("summary") {
+		report.Summaries = teamSummaries(report.Members, rng)
+	}
+
+	if format == output.FormatMarkdown {
+		fmt.Print(output.TeamMarkdown(report, rng))
+	} else {
+		output.PrintTeam(report, rng, reportOptions())
+	}
+
+	output.PrintWarnings(failed)
+	return strictError(failed)
+}
+
+// teamSummaries는 사람마다 AI 요약을 만든다. 실패한 사람은 커밋 목록을 그대로 보여주도록 빈 채로 둔다.
+func teamSummaries(people []git.Person, rng period.Range) map[string]string {
+	provider, err := aiProvider()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "\n"+i18n.T("cmd.ai_failed", err))
+		return nil
+	}
+
+	since := rng.FirstDay().Format("2006-01-02")
+	summaries := make(map[string]string, len(people))
+	fmt.Fprintln(os.Stderr)
+	for _, p := range people {
+		if p.Commits() == 0 {
+			continue
+		}
+		fmt.Fprintln(os.Stderr, i18n.T("cmd.ai_person", p.Name, provider.Name()))
+		summaries[p.Name] = summarizeWith(provider, ai.BuildPersonPrompt(p.Name, p.Results, since))
+	}
+	return summaries
+}
@@ -2,16 +2,22 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"text/template"
 	"time"
 
-	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"github.com/kso1204/gitday/internal/ai"
 	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
 	"github.com/kso1204/gitday/internal/output"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/kso1204/gitday/internal/stats"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var todayCmd = &cobra.Command{
@@ -21,99 +27,355 @@ var todayCmd = &cobra.Command{
 }
 
 func init() {
+	todayCmd.Flags().String("format", "", "출력 형식: text, markdown, json, ndjson (기본: text)")
 	rootCmd.AddCommand(todayCmd)
 }
 
 func runToday(cmd *cobra.Command, args []string) error {
-	now := time.Now()
-	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	return runReport(since, now, "today")
+	format, err := formatFlag(cmd, reportFormats...)
+	if err != nil {
+		return err
+	}
+	rng, err := resolveRange(cmd, period.Today)
+	if err != nil {
+		return err
+	}
+	return runReport(cmd, rng, format)
+}
+
+// reportFormats는 today/week가 지원하는 출력 형식이다. 첫 번째가 기본값이다.
+var reportFormats = []string{output.FormatText, output.FormatMarkdown, output.FormatJSON, output.FormatNDJSON}
+
+// resolveRange는 --period/--since/--until 플래그로 조회 기간을 정한다.
+// --period가 없으면 명령별 기본 기간(defaultPeriod)을 쓴다.
+func resolveRange(cmd *cobra.Command, defaultPeriod string) (period.Range, error) {
+	expr, _ := cmd.Flags().GetString("period")
+	if expr == "" {
+		expr = defaultPeriod
+	}
+	since, _ := cmd.Flags().GetString("since")
+	until, _ := cmd.Flags().GetString("until")
+
+	cal, err := calendar()
+	if err != nil {
+		return period.Range{}, err
+	}
+	return cal.Resolve(expr, since, until, time.Now())
 }
 
-func runReport(since, until time.Time, period string) error {
-	scanPaths := viper.GetStringSlice("scan_paths")
-	excludes := viper.GetStringSlice("exclude")
-	author := viper.GetString("author")
+// calendar는 timezone/day_start 설정으로 기간 계산용 달력을 만든다.
+func calendar() (period.Calendar, error) {
+	cal, err := period.NewCalendar(viper.GetString("timezone"), viper.GetString("day_start"))
+	if err != nil {
+		return period.Calendar{}, errorf("cmd.config_error", err)
+	}
+	return cal, nil
+}
+
+func runReport(cmd *cobra.Command, rng period.Range, format string) error {
+	since, until := rng.Since, rng.Until
+	machine := output.IsMachineFormat(format)
+
+	tmpl, err := userTemplate(cmd)
+	if err != nil {
+		return err
+	}
 
 	// 1. 레포 스캔
-	repos, err := git.ScanRepos(scanPaths, excludes)
+	repos, err := scanRepos()
 	if err != nil {
-		return fmt.Errorf("레포 스캔 실패: %w", err)
+		return errorf("cmd.scan_failed", err)
 	}
 
-	if len(repos) == 0 {
-		fmt.Println("스캔된 Git 레포가 없습니다. gitday init으로 scan_paths를 설정하세요.")
+	if len(repos) == 0 && !machine {
+		fmt.Println(i18n.T("cmd.no_repos"))
 		return nil
 	}
 
-	// 2. 커밋 로그 수집
-	results, err := git.CollectLogs(repos, since, until, author)
+	// 2. 커밋 로그 수집 (레포별 실패는 경고로 출력)
+	results, failed, err := collectReportLogs(repos, since, until, format, tmpl)
 	if err != nil {
-		return fmt.Errorf("커밋 로그 수집 실패: %w", err)
+		return err
 	}
 
-	if len(results) == 0 {
-		fmt.Printf("📭 %s ~ %s 기간에 커밋이 없습니다.\n",
+	// JSON 등은 커밋이 없어도 빈 리포트를 출력해 스크립트가 그대로 파싱할 수 있게 한다
+	if len(results) == 0 && !machine {
+		fmt.Println(i18n.T("cmd.no_commits",
 			since.Format("2006-01-02"),
-			until.Format("2006-01-02 15:04"))
-		return nil
+			until.Format("2006-01-02 15:04")))
+		output.PrintWarnings(failed)
+		return strictError(failed)
 	}
 
-	// 3. 터미널 출력
-	compact := viper.GetBool("output.compact")
-	output.PrintReport(results, since, until, compact)
+	summary := viper.GetBool("summary") && len(results) > 0
+	summaryText := ""
+
+	if tmpl != nil {
+		// 3. 사용자 템플릿 출력
+		if summary {
+			summaryText = getSummary(results, rng)
+		}
+		doc, err := output.RenderTemplate(tmpl, results, rng, summaryText)
+		if err != nil {
+			return err
+		}
+		os.Stdout.Write(doc)
+	} else if format == output.FormatText {
+		// 3. 터미널 출력 → AI 요약 (리포트를 먼저 보여주고 요약을 기다린다)
+		output.PrintReport(results, rng, reportOptions())
+		if summary {
+			summaryText = getSummary(results, rng)
+			if summaryText != "" {
+				output.PrintSummary(summaryText)
+			}
+		}
+	} else {
+		// 3. 문서 형식은 요약까지 포함해 한 번에 출력
+		if summary {
+			summaryText = getSummary(results, rng)
+		}
+		doc, err := output.Render(format, results, rng, summaryText, reportOptions())
+		if err != nil {
+			return err
+		}
+		os.Stdout.Write(doc)
+	}
 
-	// 4. AI 요약 + 로그 저장 (--summary 플래그)
-	summary := viper.GetBool("summary")
+	// 4. 로그 저장 (--summary 플래그)
 	if summary {
-		summaryText := getSummary(results, since)
-		if summaryText != "" {
-			output.PrintSummary(summaryText)
+		saveLog(results, rng, summaryText)
+	}
+
+	// 5. 수집 실패 경고
+	output.PrintWarnings(failed)
+	return strictError(failed)
+}
+
+// formatFlag는 --format 값을 읽고 명령이 지원하는 형식인지 확인한다.
+// --template 플래그와 함께 쓰면 어느 쪽으로 출력할지 모호하므로 에러를 반환한다.
+// 설정 파일(template)의 기본 템플릿은 --format이 대신한다 (userTemplate).
+func formatFlag(cmd *cobra.Command, allowed ...string) (string, error) {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "" && cmd.Flags().Changed("template") {
+		return "", errors.New(i18n.T("cmd.format_template"))
+	}
+	if format == "" {
+		return allowed[0], nil
+	}
+	for _, f := range allowed {
+		if format == f {
+			return format, nil
+		}
+	}
+	return "", errors.New(i18n.T("cmd.unsupported_format", format, strings.Join(allowed, "/")))
+}
+
+// userTemplate은 --template 값(설정 파일의 template 포함)으로 사용자 템플릿을 읽는다.
+// 값이 templates 설정에 등록된 이름이면 그 경로를, 아니면 파일 경로로 본다.
+// 템플릿이 없거나, 설정 파일의 템플릿인데 --format/--markdown으로 형식을 직접 골랐으면 nil을 반환한다.
+func userTemplate(cmd *cobra.Command) (*template.Template, error) {
+	name := viper.GetString("template")
+	if name == "" {
+		return nil, nil
+	}
+	if !cmd.Flags().Changed("template") && (cmd.Flags().Changed("format") || cmd.Flags().Changed("markdown")) {
+		return nil, nil
+	}
+
+	path := name
+	if registered := viper.GetStringMapString("templates")[strings.ToLower(name)]; registered != "" {
+		path = registered
+	}
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[2:])
 		}
-		// 로그 자동 저장
-		saveLog(results, since, until, period, summaryText)
 	}
+	return output.LoadTemplate(path)
+}
+
+// scanRepos는 설정의 scan_paths/exclude/scan_depth로 레포를 탐색한다.
+func scanRepos() ([]git.Repo, error) {
+	return git.ScanRepos(
+		viper.GetStringSlice("scan_paths"),
+		viper.GetStringSlice("exclude"),
+		git.ScanOptions{
+			Depth:  viper.GetInt("scan_depth"),
+			Nested: viper.GetBool("scan_nested"),
+		},
+	)
+}
+
+// logOptions는 설정/플래그에서 로그 수집 옵션을 구성한다.
+func logOptions() (git.LogOptions, error) {
+	tickets, err := git.NewTicketMatcher(viper.GetStringSlice("tickets.patterns"), viper.GetStringSlice("tickets.ignore"))
+	if err != nil {
+		return git.LogOptions{}, errorf("cmd.config_error", err)
+	}
+	groupBy := viper.GetString("output.group_by")
+	return git.LogOptions{
+		Authors:   authors(),
+		WithFiles: viper.GetBool("output.files"),
+		Tickets:   tickets,
+		// 커밋마다 작성 브랜치를 찾아야 하므로 브랜치/티켓별 묶음이나 --branch일 때만 수집한다.
+		// 티켓은 작성 브랜치 이름에서도 찾는다. 결과에 브랜치가 실리는 형식은 collectReportLogs가 따로 켠다
+		WithBranches: groupBy == git.GroupByBranch || groupBy == git.GroupByTicket || len(viper.GetStringSlice("branches")) > 0,
+		Branches:     viper.GetStringSlice("branches"),
+		NoMerges:     mergesMode() == git.MergesHide,
+	}, nil
+}
 
+// authors는 author 설정/--author 플래그의 작성자 목록이다.
+// 설정 파일에는 문자열 하나("wook")와 목록을 모두 쓸 수 있다.
+func authors() []string {
+	switch v := viper.Get("author").(type) {
+	case string:
+		return git.SplitAuthors(v)
+	case []string:
+		return git.SplitAuthors(strings.Join(v, ","))
+	case []any:
+		var list []string
+		for _, a := range v {
+			list = append(list, git.SplitAuthors(fmt.Sprint(a))...)
+		}
+		return list
+	}
 	return nil
 }
 
-func getSummary(results []git.RepoResult, since time.Time) string {
-	providerName := viper.GetString("ai.provider")
-	apiKey := viper.GetString("ai.api_key")
-	model := viper.GetString("ai.model")
-	ollamaURL := viper.GetString("ai.ollama_url")
+// mergesMode는 병합 커밋 처리 방식이다. --no-merges가 merges 설정보다 우선한다.
+func mergesMode() string {
+	if noMerges, _ := rootCmd.PersistentFlags().GetBool("no-merges"); noMerges {
+		return git.MergesHide
+	}
+	return viper.GetString("merges")
+}
 
-	if envKey := os.Getenv("GITDAY_API_KEY"); envKey != "" {
-		apiKey = envKey
-	} else if envKey := os.Getenv("ANTHROPIC_API_KEY"); envKey != "" && providerName == "claude" {
-		apiKey = envKey
-	} else if envKey := os.Getenv("OPENAI_API_KEY"); envKey != "" && providerName == "openai" {
-		apiKey = envKey
+// collectLogs는 설정/플래그의 옵션으로 커밋 로그를 수집한다.
+// 레포별 실패는 failed로 따로 돌려주고, 그 외의 에러만 err로 반환한다.
+func collectLogs(repos []git.Repo, since, until time.Time) (results []git.RepoResult, failed []*git.RepoError, err error) {
+	opts, err := logOptions()
+	if err != nil {
+		return nil, nil, err
 	}
+	return collectLogsWith(repos, since, until, opts)
+}
 
-	provider, err := ai.NewProvider(providerName, apiKey, model, ollamaURL)
+// collectReportLogs는 collectLogs와 같지만 json/ndjson과 사용자 템플릿처럼
+// 커밋의 branch/branches를 내보내는 출력이면 브랜치 정보도 수집한다.
+func collectReportLogs(repos []git.Repo, since, until time.Time, format string, tmpl *template.Template) (results []git.RepoResult, failed []*git.RepoError, err error) {
+	opts, err := logOptions()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "\n⚠ AI 요약 실패: %v\n", err)
-		return ""
+		return nil, nil, err
+	}
+	if format == output.FormatJSON || format == output.FormatNDJSON || tmpl != nil {
+		opts.WithBranches = true
+	}
+	return collectLogsWith(repos, since, until, opts)
+}
+
+// collectLogsWith는 collectLogs와 같지만 로그 옵션을 직접 받는다 (tui에서 작성자를 바꿀 때 등).
+func collectLogsWith(repos []git.Repo, since, until time.Time, opts git.LogOptions) (results []git.RepoResult, failed []*git.RepoError, err error) {
+	results, err = git.CollectLogs(repos, since, until, opts)
+
+	var collectErr *git.CollectError
+	if errors.As(err, &collectErr) {
+		failed = collectErr.Errors
+	} else if err != nil {
+		return nil, nil, errorf("cmd.collect_failed", err)
+	}
+
+	if err := git.CheckMerges(mergesMode()); err != nil {
+		return nil, nil, err
+	}
+	results = git.ApplyMerges(results, mergesMode())
+
+	if err := git.SortResults(results, viper.GetString("output.sort")); err != nil {
+		return nil, nil, err
+	}
+	if err := git.SortCommits(results, viper.GetString("output.commit_order")); err != nil {
+		return nil, nil, err
+	}
+	if err := git.CheckGroupBy(viper.GetString("output.group_by")); err != nil {
+		return nil, nil, err
+	}
+	return results, failed, nil
+}
+
+// strictError는 --strict 모드에서 실패한 레포가 있으면 에러를 반환한다.
+func strictError(failed []*git.RepoError) error {
+	if len(failed) == 0 || !viper.GetBool("strict") {
+		return nil
+	}
+	return errors.New(i18n.T("cmd.strict", len(failed)))
+}
+
+// reportOptions는 설정/플래그에서 출력 옵션을 구성한다.
+func reportOptions() output.Options {
+	return output.Options{
+		Compact:   viper.GetBool("output.compact"),
+		ShowFiles: viper.GetBool("output.files"),
+		Stats:     viper.GetBool("output.stats"),
+		GroupBy:   viper.GetString("output.group_by"),
+		Width:     viper.GetInt("output.width"),
+		Overflow:  viper.GetString("output.overflow"),
+		Timesheet: stats.TimesheetOptions{
+			MaxGap:      viper.GetDuration("timesheet.max_gap"),
+			FirstCommit: viper.GetDuration("timesheet.first_commit"),
+		},
 	}
+}
 
-	prompt := ai.BuildPrompt(results, since.Format("2006-01-02"))
+func getSummary(results []git.RepoResult, rng period.Range) string {
+	return summarize(ai.BuildGroupedPrompt(results, rng.FirstDay().Format("2006-01-02"), viper.GetString("output.group_by")))
+}
+
+// summarize는 설정된 AI 프로바이더로 프롬프트를 요약한다. 실패하면 경고만 출력하고 빈 문자열을 반환한다.
+func summarize(prompt string) string {
+	provider, err := aiProvider()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "\n"+i18n.T("cmd.ai_failed", err))
+		return ""
+	}
 
-	fmt.Printf("\n📝 AI 요약 생성 중 (%s)...\n", provider.Name())
+	fmt.Fprintln(os.Stderr, "\n"+i18n.T("cmd.ai_generating", provider.Name()))
+	return summarizeWith(provider, prompt)
+}
 
+// summarizeWith는 이미 만든 프로바이더로 프롬프트를 요약한다 (여러 번 요약할 때).
+// 실패하면 경고만 출력하고 빈 문자열을 반환한다.
+func summarizeWith(provider ai.Provider, prompt string) string {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	text, err := provider.Summarize(ctx, prompt)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "⚠ AI 요약 실패: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cmd.ai_failed", err))
 		return ""
 	}
 
 	return text
 }
 
-func saveLog(results []git.RepoResult, since, until time.Time, period, summaryText string) {
+// aiProvider는 설정과 환경변수로 AI 프로바이더를 만든다.
+func aiProvider() (ai.Provider, error) {
+	providerName := viper.GetString("ai.provider")
+	apiKey := viper.GetString("ai.api_key")
+	model := viper.GetString("ai.model")
+	ollamaURL := viper.GetString("ai.ollama_url")
+
+	if envKey := os.Getenv("GITDAY_API_KEY"); envKey != "" {
+		apiKey = envKey
+	} else if envKey := os.Getenv("ANTHROPIC_API_KEY"); envKey != "" && providerName == "claude" {
+		apiKey = envKey
+	} else if envKey := os.Getenv("OPENAI_API_KEY"); envKey != "" && providerName == "openai" {
+		apiKey = envKey
+	}
+
+	return ai.NewProvider(providerName, apiKey, model, ollamaURL)
+}
+
+func saveLog(results []git.RepoResult, rng period.Range, summaryText string) {
 	home, err := os.UserHomeDir()
 	if err != nil {
 		return
@@ -124,17 +386,28 @@ func saveLog(results []git.RepoResult, since, until time.Time, period, summaryTe
 		return
 	}
 
-	filename := since.Format("2006-01-02") + ".md"
-	if period == "week" {
-		filename = since.Format("2006-01-02") + "_week.md"
-	}
-	logPath := filepath.Join(logDir, filename)
+	logPath := filepath.Join(logDir, logFilename(rng))
 
-	md := output.ToMarkdown(results, since, until, summaryText)
+	md := output.ToMarkdown(results, rng, summaryText, reportOptions())
 	if err := os.WriteFile(logPath, []byte(md), 0600); err != nil {
-		fmt.Fprintf(os.Stderr, "⚠ 로그 저장 실패: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cmd.log_save_failed", err))
 		return
 	}
 
-	fmt.Printf("\n✓ 저장됨: %s\n", logPath)
+	fmt.Fprintln(os.Stderr, "\n"+i18n.T("cmd.log_saved", logPath))
+}
+
+// logFilename은 기간에 맞는 로그 파일 이름을 만든다.
+// today는 2026-02-26.md, 그 외는 시작일 뒤에 기간 이름(직접 지정 기간은 종료일)을 붙인다.
+// 날짜는 day_start/timezone 설정 기준의 논리적 날짜이다.
+func logFilename(rng period.Range) string {
+	start := rng.FirstDay().Format("2006-01-02")
+	switch rng.Name {
+	case period.Today:
+		return start + ".md"
+	case period.Custom:
+		return start + "_" + rng.LastDay().Format("2006-01-02") + ".md"
+	default:
+		return start + "_" + rng.Name + ".md"
+	}
 }
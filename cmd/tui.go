@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kso1204/gitday/internal/ai"
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/output"
+	"github.com/kso1204/gitday/internal/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "레포별 커밋을 둘러보는 대화형 화면",
+	Long: `기간 안의 커밋을 레포별로 보여주는 전체 화면 UI.
+커밋을 펼쳐 파일별 변경량을 보고, 기간(p)과 작성자(a)를 바꿔 다시 불러오고,
+AI 요약(s)과 마크다운 내보내기(e)를 화면 안에서 실행할 수 있다.
+처음 기간은 --period(기본 today), 작성자는 --author/author 설정을 따른다.`,
+	RunE: runTUI,
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+}
+
+func runTUI(cmd *cobra.Command, args []string) error {
+	expr, _ := cmd.Flags().GetString("period")
+	if expr == "" {
+		expr = "today"
+	}
+	since, _ := cmd.Flags().GetString("since")
+	until, _ := cmd.Flags().GetString("until")
+	noColor, _ := cmd.Flags().GetBool("no-color")
+
+	cal, err := calendar()
+	if err != nil {
+		return err
+	}
+
+	// 레포 스캔은 한 번만 하고, 기간/작성자를 바꿀 때는 로그만 다시 수집한다
+	repos, err := scanRepos()
+	if err != nil {
+		return errorf("cmd.scan_failed", err)
+	}
+
+	opts, err := logOptions()
+	if err != nil {
+		return err
+	}
+	// 펼친 커밋에 파일 목록을 보여주므로 --files와 상관없이 항상 수집한다
+	opts.WithFiles = true
+
+	return tui.Run(tui.Config{
+		Period: expr,
+		Since:  since,
+		Until:  until,
+		Author: strings.Join(authors(), ", "),
+		Color:  viper.GetBool("output.color") && !noColor && os.Getenv("NO_COLOR") == "",
+
+		Load: func(periodExpr, since, until, author string) (tui.Report, error) {
+			rng, err := cal.Resolve(periodExpr, since, until, time.Now())
+			if err != nil {
+				return tui.Report{}, err
+			}
+			opts := opts
+			opts.Authors = git.SplitAuthors(author)
+			results, failed, err := collectLogsWith(repos, rng.Since, rng.Until, opts)
+			if err != nil {
+				return tui.Report{}, err
+			}
+			return tui.Report{Range: rng, Results: results, Failed: failed}, nil
+		},
+
+		Summarize: func(ctx context.Context, r tui.Report) (string, error) {
+			provider, err := aiProvider()
+			if err != nil {
+				return "", err
+			}
+			return provider.Summarize(ctx, ai.BuildGroupedPrompt(r.Results, r.Range.FirstDay().Format("2006-01-02"), viper.GetString("output.group_by")))
+		},
+
+		Export: func(r tui.Report, summary, path string) error {
+			md := output.ToMarkdown(r.Results, r.Range, summary, reportOptions())
+			if err := os.WriteFile(path, []byte(md), 0644); err != nil {
+				return errorf("cmd.write_failed", err)
+			}
+			return nil
+		},
+
+		ExportPath: func(r tui.Report) string {
+			return "gitday-" + logFilename(r.Range)
+		},
+	})
+}
@@ -1,8 +1,7 @@
 package cmd
 
 import (
-	"time"
-
+	"github.com/kso1204/gitday/internal/period"
 	"github.com/spf13/cobra"
 )
 
@@ -13,19 +12,18 @@ var weekCmd = &cobra.Command{
 }
 
 func init() {
+	weekCmd.Flags().String("format", "", "출력 형식: text, markdown, json, ndjson (기본: text)")
 	rootCmd.AddCommand(weekCmd)
 }
 
 func runWeek(cmd *cobra.Command, args []string) error {
-	now := time.Now()
-
-	// 이번 주 월요일 00:00
-	weekday := int(now.Weekday())
-	if weekday == 0 {
-		weekday = 7 // 일요일
+	format, err := formatFlag(cmd, reportFormats...)
+	if err != nil {
+		return err
 	}
-	monday := now.AddDate(0, 0, -(weekday - 1))
-	since := time.Date(monday.Year(), monday.Month(), monday.Day(), 0, 0, 0, 0, now.Location())
-
-	return runReport(since, now, "week")
+	rng, err := resolveRange(cmd, period.Week)
+	if err != nil {
+		return err
+	}
+	return runReport(cmd, rng, format)
 }
@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/kso1204/gitday/internal/i18n"
 )
 
 type Claude struct {
@@ -48,7 +49,7 @@ func (c *Claude) Summarize(ctx context.Context, prompt string) (string, error) {
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("Claude API 호출 실패: %w", err)
+		return "", i18n.Errorf("err.api_call", "Claude", err)
 	}
 	defer resp.Body.Close()
 
@@ -58,7 +59,7 @@ func (c *Claude) Summarize(ctx context.Context, prompt string) (string, error) {
 	}
 
 	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("Claude API 에러 (%d): %s", resp.StatusCode, string(respBody))
+		return "", i18n.Errorf("err.api_status", "Claude", resp.StatusCode, string(respBody))
 	}
 
 	var result struct {
@@ -71,7 +72,7 @@ func (c *Claude) Summarize(ctx context.Context, prompt string) (string, error) {
 	}
 
 	if len(result.Content) == 0 {
-		return "", fmt.Errorf("Claude 응답이 비어있습니다")
+		return "", i18n.Errorf("err.api_empty", "Claude")
 	}
 
 	return result.Content[0].Text, nil
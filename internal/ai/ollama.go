@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/kso1204/gitday/internal/i18n"
 )
 
 type Ollama struct {
@@ -51,7 +52,7 @@ func (o *Ollama) Summarize(ctx context.Context, prompt string) (string, error) {
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("Ollama 연결 실패 (%s): %w", o.baseURL, err)
+		return "", i18n.Errorf("err.ollama_connect", o.baseURL, err)
 	}
 	defer resp.Body.Close()
 
@@ -61,7 +62,7 @@ func (o *Ollama) Summarize(ctx context.Context, prompt string) (string, error) {
 	}
 
 	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("Ollama API 에러 (%d): %s", resp.StatusCode, string(respBody))
+		return "", i18n.Errorf("err.api_status", "Ollama", resp.StatusCode, string(respBody))
 	}
 
 	var result struct {
@@ -76,7 +77,7 @@ func (o *Ollama) Summarize(ctx context.Context, prompt string) (string, error) {
 	}
 
 	if len(result.Choices) == 0 {
-		return "", fmt.Errorf("Ollama 응답이 비어있습니다")
+		return "", i18n.Errorf("err.api_empty", "Ollama")
 	}
 
 	return result.Choices[0].Message.Content, nil
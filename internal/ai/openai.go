@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/kso1204/gitday/internal/i18n"
 )
 
 type OpenAI struct {
@@ -47,7 +48,7 @@ func (o *OpenAI) Summarize(ctx context.Context, prompt string) (string, error) {
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("OpenAI API 호출 실패: %w", err)
+		return "", i18n.Errorf("err.api_call", "OpenAI", err)
 	}
 	defer resp.Body.Close()
 
@@ -57,7 +58,7 @@ func (o *OpenAI) Summarize(ctx context.Context, prompt string) (string, error) {
 	}
 
 	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("OpenAI API 에러 (%d): %s", resp.StatusCode, string(respBody))
+		return "", i18n.Errorf("err.api_status", "OpenAI", resp.StatusCode, string(respBody))
 	}
 
 	var result struct {
@@ -72,7 +73,7 @@ func (o *OpenAI) Summarize(ctx context.Context, prompt string) (string, error) {
 	}
 
 	if len(result.Choices) == 0 {
-		return "", fmt.Errorf("OpenAI 응답이 비어있습니다")
+		return "", i18n.Errorf("err.api_empty", "OpenAI")
 	}
 
 	return result.Choices[0].Message.Content, nil
@@ -3,9 +3,12 @@ package ai
 import (
 	"context"
 	"fmt"
+	"path"
+	"sort"
 	"strings"
 
 	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
 )
 
 // Provider는 AI 요약 프로바이더 인터페이스이다.
@@ -19,36 +22,248 @@ func NewProvider(providerName, apiKey, model, ollamaURL string) (Provider, error
 	switch strings.ToLower(providerName) {
 	case "claude":
 		if apiKey == "" {
-			return nil, fmt.Errorf("Claude API 키가 필요합니다 (GITDAY_API_KEY 환경변수 또는 설정 파일)")
+			return nil, i18n.Errorf("err.api_key", "Claude")
 		}
 		return NewClaude(apiKey, model), nil
 	case "openai":
 		if apiKey == "" {
-			return nil, fmt.Errorf("OpenAI API 키가 필요합니다 (GITDAY_API_KEY 환경변수 또는 설정 파일)")
+			return nil, i18n.Errorf("err.api_key", "OpenAI")
 		}
 		return NewOpenAI(apiKey, model), nil
 	case "ollama":
 		return NewOllama(ollamaURL, model), nil
 	default:
-		return nil, fmt.Errorf("지원하지 않는 AI 프로바이더: %s (claude/openai/ollama)", providerName)
+		return nil, i18n.Errorf("err.provider", providerName)
 	}
 }
 
 // BuildPrompt는 커밋 데이터로 요약 프롬프트를 생성한다.
+// 지시문과 응답 언어는 language 설정(i18n)을 따른다.
 func BuildPrompt(results []git.RepoResult, since string) string {
+	return BuildGroupedPrompt(results, since, git.GroupByRepo)
+}
+
+// BuildGroupedPrompt는 BuildPrompt와 같지만 커밋 목록을 묶음 기준(--group-by)대로 나눠 넘긴다.
+// type/scope로 묶으면 "Features / Fixes" 같은 구조가, ticket/branch로 묶으면 이슈/브랜치별 구조가 요약에도 드러난다.
+func BuildGroupedPrompt(results []git.RepoResult, since, groupBy string) string {
 	var sb strings.Builder
-	sb.WriteString("다음은 개발자의 Git 커밋 로그입니다. 이 내용을 바탕으로 오늘 한 일을 자연어로 간결하게 요약해주세요.\n")
-	sb.WriteString("- 프로젝트별로 핵심 작업을 1-2문장으로 요약\n")
-	sb.WriteString("- 마지막에 전체적인 한줄 요약 추가\n")
-	sb.WriteString("- 한국어로 작성\n\n")
+	sb.WriteString(i18n.T("prompt.report"))
+	sb.WriteString("\n")
 
+	if groupBy != "" && groupBy != git.GroupByRepo {
+		writeGroupedLog(&sb, results, groupBy)
+	} else {
+		writeCommitLog(&sb, results, false)
+	}
+	return sb.String()
+}
+
+// BuildStandupPrompt는 스탠드업(어제 한 일 / 오늘 할 일 / 블로커) 형식의 프롬프트를 생성한다.
+// since는 직전 근무일 날짜이며, 커밋 시각을 함께 넘겨 그 이후 작업과 구분할 수 있게 한다.
+func BuildStandupPrompt(results []git.RepoResult, since string) string {
+	var sb strings.Builder
+	sb.WriteString(i18n.T("prompt.standup", since))
+	sb.WriteString("\n")
+
+	writeCommitLog(&sb, results, true)
+	return sb.String()
+}
+
+// BuildPersonPrompt는 팀 리포트(gitday team)에서 한 사람의 커밋을 요약하는 프롬프트를 생성한다.
+// since는 기간 시작일이다.
+func BuildPersonPrompt(name string, results []git.RepoResult, since string) string {
+	var sb strings.Builder
+	sb.WriteString(i18n.T("prompt.person", name, since))
+	sb.WriteString("\n")
+
+	writeCommitLog(&sb, results, false)
+	return sb.String()
+}
+
+// writeCommitLog는 레포별 커밋 목록을 프롬프트 본문 형식으로 쓴다.
+// withTime이면 각 커밋 앞에 작성 시각을 붙인다.
+func writeCommitLog(sb *strings.Builder, results []git.RepoResult, withTime bool) {
 	for _, r := range results {
-		sb.WriteString(fmt.Sprintf("## %s (%d commits)\n", r.Name, len(r.Commits)))
+		name := r.Name
+		if label := r.Label(); label != "" {
+			name += " [" + label + "]"
+		}
+		sb.WriteString(fmt.Sprintf("## %s (%d commits, +%d -%d)\n",
+			name, len(r.Commits), r.TotalInsertions(), r.TotalDeletions()))
 		for _, c := range r.Commits {
-			sb.WriteString(fmt.Sprintf("- %s\n", c.Message))
+			prefix := ""
+			if withTime {
+				prefix = "[" + c.Date.Format("01-02 15:04") + "] "
+			}
+			msg := c.Message + ticketSuffix(c)
+			if c.Files > 0 {
+				sb.WriteString(fmt.Sprintf("- %s%s (%d files, +%d -%d)\n", prefix, msg, c.Files, c.Insertions, c.Deletions))
+			} else {
+				sb.WriteString(fmt.Sprintf("- %s%s\n", prefix, msg))
+			}
+		}
+		if len(r.Merges) > 0 {
+			sb.WriteString(i18n.T("prompt.merges", git.MergeSummary(r.Merges)) + "\n")
+		}
+		if branches := commitBranches(r.Commits); branches != "" {
+			sb.WriteString(i18n.T("prompt.branches", branches) + "\n")
+		}
+		if types := commitTypes(r.Commits); types != "" {
+			sb.WriteString(i18n.T("prompt.types", types) + "\n")
+		}
+		if areas := changedAreas(r.Commits); len(areas) > 0 {
+			sb.WriteString(i18n.T("prompt.areas", strings.Join(areas, ", ")) + "\n")
 		}
 		sb.WriteString("\n")
 	}
+}
 
-	return sb.String()
+// writeGroupedLog는 레포를 가로질러 유형/범위별로 묶은 커밋 목록을 쓴다.
+// 묶음 제목은 사람이 읽는 이름 대신 "type: feat"처럼 원래 값을 써서 모델이 그대로 해석하게 한다.
+// 병합 커밋은 어느 묶음에도 속하지 않으므로 끝에 레포별로 한 줄씩 붙인다.
+func writeGroupedLog(sb *strings.Builder, results []git.RepoResult, groupBy string) {
+	groups, _ := git.GroupCommits(results, groupBy)
+	for _, g := range groups {
+		key := g.Key
+		switch {
+		case groupBy == git.GroupByType && key == git.GroupBreaking:
+			key = "BREAKING CHANGE"
+		case key == "":
+			key = "-"
+		}
+		if g.Repo != "" {
+			key = g.Repo + " › " + key
+		}
+		ins, del := 0, 0
+		for _, c := range g.Commits {
+			ins += c.Insertions
+			del += c.Deletions
+		}
+		sb.WriteString(fmt.Sprintf("## %s: %s (%d commits, +%d -%d)\n", groupBy, key, len(g.Commits), ins, del))
+		for _, c := range g.Commits {
+			msg := git.GroupedMessage(c.Commit, g.Key, groupBy)
+			if g.Repo == "" {
+				msg = "[" + c.Repo + "] " + msg
+			}
+			if groupBy != git.GroupByTicket {
+				msg += ticketSuffix(c.Commit)
+			}
+			if c.Files > 0 {
+				sb.WriteString(fmt.Sprintf("- %s (%d files, +%d -%d)\n", msg, c.Files, c.Insertions, c.Deletions))
+			} else {
+				sb.WriteString(fmt.Sprintf("- %s\n", msg))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	for _, r := range results {
+		if len(r.Merges) > 0 {
+			sb.WriteString(i18n.T("prompt.merges", "["+r.Name+"] "+git.MergeSummary(r.Merges)) + "\n")
+		}
+	}
+}
+
+// ticketSuffix는 메시지에 없는 이슈 키(브랜치 이름에서 찾은 키 등)를 " [PAY-1234]" 형태로 만든다.
+func ticketSuffix(c git.Commit) string {
+	var extra []string
+	for _, t := range c.Tickets {
+		if !strings.Contains(c.Message, t) {
+			extra = append(extra, t)
+		}
+	}
+	if len(extra) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(extra, ", ") + "]"
+}
+
+// commitBranches는 작성 브랜치별 커밋 수를 "feature/login 4, main 2" 형태로 만든다.
+// 브랜치를 수집하지 않았으면(LogOptions.WithBranches) 빈 문자열이다.
+func commitBranches(commits []git.Commit) string {
+	counts := make(map[string]int)
+	var order []string
+	for _, c := range commits {
+		if c.Branch == "" {
+			continue
+		}
+		if counts[c.Branch] == 0 {
+			order = append(order, c.Branch)
+		}
+		counts[c.Branch]++
+	}
+
+	parts := make([]string, len(order))
+	for i, b := range order {
+		parts[i] = fmt.Sprintf("%s %d", b, counts[b])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// commitTypes는 Conventional Commits 유형별 커밋 수를 "feat 2, fix 1, breaking 1" 형태로 만든다.
+// 형식에 맞는 커밋이 없으면 빈 문자열이다.
+func commitTypes(commits []git.Commit) string {
+	counts := make(map[string]int)
+	var order []string
+	breaking := 0
+	for _, c := range commits {
+		cc := c.Conventional()
+		if cc.Type == "" {
+			continue
+		}
+		if counts[cc.Type] == 0 {
+			order = append(order, cc.Type)
+		}
+		counts[cc.Type]++
+		if cc.Breaking {
+			breaking++
+		}
+	}
+	if len(order) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return counts[order[i]] > counts[order[j]] })
+	parts := make([]string, 0, len(order)+1)
+	for _, t := range order {
+		parts = append(parts, fmt.Sprintf("%s %d", t, counts[t]))
+	}
+	if breaking > 0 {
+		parts = append(parts, fmt.Sprintf("breaking %d", breaking))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// maxAreas는 프롬프트에 넣을 변경 영역(디렉토리) 최대 개수이다.
+const maxAreas = 5
+
+// changedAreas는 파일별 변경 내역에서 변경량이 큰 디렉토리를 최대 maxAreas개 뽑는다.
+// 디렉토리는 상위 2단계까지만 본다 (예: internal/git/log.go → internal/git).
+func changedAreas(commits []git.Commit) []string {
+	churn := make(map[string]int)
+	for _, c := range commits {
+		for _, fc := range c.Changes {
+			area := path.Dir(fc.Path)
+			if parts := strings.Split(area, "/"); len(parts) > 2 {
+				area = strings.Join(parts[:2], "/")
+			}
+			churn[area] += fc.Insertions + fc.Deletions + 1
+		}
+	}
+
+	areas := make([]string, 0, len(churn))
+	for a := range churn {
+		areas = append(areas, a)
+	}
+	sort.Slice(areas, func(i, j int) bool {
+		if churn[areas[i]] != churn[areas[j]] {
+			return churn[areas[i]] > churn[areas[j]]
+		}
+		return areas[i] < areas[j]
+	})
+
+	if len(areas) > maxAreas {
+		areas = areas[:maxAreas]
+	}
+	return areas
 }
@@ -3,8 +3,10 @@ package ai
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
 )
 
 func TestNewProvider_Claude(t *testing.T) {
@@ -56,7 +58,7 @@ func TestBuildPrompt(t *testing.T) {
 		{
 			Name: "rpg",
 			Commits: []git.Commit{
-				{Message: "전투 시스템 수정"},
+				{Message: "전투 시스템 수정", Files: 3, Insertions: 120, Deletions: 15},
 				{Message: "인벤토리 UI 개선"},
 			},
 		},
@@ -76,7 +78,154 @@ func TestBuildPrompt(t *testing.T) {
 	if !strings.Contains(prompt, "전투 시스템 수정") {
 		t.Error("prompt should contain commit message")
 	}
+	if !strings.Contains(prompt, "rpg (2 commits, +120 -15)") {
+		t.Error("prompt should contain repo churn")
+	}
+	if !strings.Contains(prompt, "전투 시스템 수정 (3 files, +120 -15)") {
+		t.Error("prompt should contain commit churn")
+	}
 	if !strings.Contains(prompt, "한국어") {
 		t.Error("prompt should request Korean")
 	}
 }
+
+func TestChangedAreas(t *testing.T) {
+	commits := []git.Commit{
+		{Changes: []git.FileChange{
+			{Path: "internal/git/log.go", Insertions: 50},
+			{Path: "internal/git/scanner.go", Insertions: 5},
+			{Path: "README.md", Insertions: 2},
+		}},
+		{Changes: []git.FileChange{
+			{Path: "cmd/today.go", Insertions: 10, Deletions: 3},
+		}},
+	}
+
+	areas := changedAreas(commits)
+	want := []string{"internal/git", "cmd", "."}
+	if strings.Join(areas, ",") != strings.Join(want, ",") {
+		t.Errorf("changedAreas = %v, want %v", areas, want)
+	}
+}
+
+func TestBuildStandupPrompt(t *testing.T) {
+	results := []git.RepoResult{
+		{
+			Name: "rpg",
+			Commits: []git.Commit{
+				{Message: "WIP: 길드 시스템", Date: time.Date(2026, 10, 16, 18, 5, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	prompt := BuildStandupPrompt(results, "2026-10-16")
+
+	for _, want := range []string{"어제 한 일", "오늘 할 일", "블로커", "2026-10-16", "[10-16 18:05] WIP: 길드 시스템"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("standup prompt should contain %q", want)
+		}
+	}
+}
+
+func TestBuildPersonPrompt(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{{Message: "결제 취소 API", Files: 2, Insertions: 40, Deletions: 3}}},
+	}
+
+	prompt := BuildPersonPrompt("Wook Kim", results, "2026-10-12")
+
+	for _, want := range []string{"Wook Kim", "2026-10-12", "3인칭", "## api (1 commits, +40 -3)", "- 결제 취소 API (2 files, +40 -3)"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("person prompt should contain %q", want)
+		}
+	}
+}
+
+func TestBuildPrompt_English(t *testing.T) {
+	if err := i18n.SetLanguage("en"); err != nil {
+		t.Fatal(err)
+	}
+	defer i18n.SetLanguage(i18n.DefaultLanguage)
+
+	results := []git.RepoResult{
+		{
+			Name: "rpg",
+			Commits: []git.Commit{
+				{Message: "fix login", Changes: []git.FileChange{{Path: "auth/login.go", Insertions: 3}}},
+			},
+		},
+	}
+
+	prompt := BuildPrompt(results, "2026-10-16")
+	for _, want := range []string{"Write in English", "Changed areas: auth", "fix login"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt should contain %q", want)
+		}
+	}
+	if strings.Contains(prompt, "한국어") {
+		t.Error("English prompt should not ask for Korean")
+	}
+
+	standup := BuildStandupPrompt(results, "2026-10-16")
+	for _, want := range []string{"### Yesterday", "### Today", "### Blockers"} {
+		if !strings.Contains(standup, want) {
+			t.Errorf("standup prompt should contain %q", want)
+		}
+	}
+}
+
+func TestBuildGroupedPrompt(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{
+			{Message: "feat(auth): 토큰 갱신", Files: 2, Insertions: 30, Deletions: 4},
+			{Message: "fix: 타임아웃"},
+		}},
+		{Name: "web", Commits: []git.Commit{
+			{Message: "feat!: 로그인 화면 교체"},
+		}, Merges: []git.Commit{
+			{Message: "Merge pull request #12 from kso/login", Merge: true},
+		}},
+	}
+
+	prompt := BuildGroupedPrompt(results, "2026-10-16", git.GroupByType)
+	for _, want := range []string{
+		"## type: BREAKING CHANGE (1 commits",
+		"- [web] feat!: 로그인 화면 교체",
+		"## type: feat (1 commits, +30 -4)",
+		"- [api] auth: 토큰 갱신 (2 files, +30 -4)",
+		"## type: fix (1 commits",
+		"병합 (커밋 수에서 제외): [web] PR #12\n",
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt should contain %q:\n%s", want, prompt)
+		}
+	}
+
+	// 레포별 프롬프트에는 유형별 커밋 수를 붙인다
+	byRepo := BuildPrompt(results, "2026-10-16")
+	if !strings.Contains(byRepo, "커밋 유형: feat 1, fix 1") || !strings.Contains(byRepo, "커밋 유형: feat 1, breaking 1") {
+		t.Errorf("repo prompt should list commit types:\n%s", byRepo)
+	}
+}
+
+func TestBuildPrompt_Tickets(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{
+			{Message: "PAY-12 결제 취소", Tickets: []string{"PAY-12"}},
+			{Message: "환불 금액 계산 수정", Tickets: []string{"PAY-13"}}, // 브랜치 이름에서 찾은 키
+		}},
+	}
+
+	prompt := BuildPrompt(results, "2026-10-16")
+	if !strings.Contains(prompt, "- PAY-12 결제 취소\n") {
+		t.Errorf("ticket already in message should not be repeated:\n%s", prompt)
+	}
+	if !strings.Contains(prompt, "- 환불 금액 계산 수정 [PAY-13]") {
+		t.Errorf("ticket from branch should be appended:\n%s", prompt)
+	}
+
+	grouped := BuildGroupedPrompt(results, "2026-10-16", git.GroupByTicket)
+	if !strings.Contains(grouped, "## ticket: PAY-13 (1 commits") {
+		t.Errorf("grouped prompt should have ticket sections:\n%s", grouped)
+	}
+}
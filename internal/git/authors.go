@@ -0,0 +1,175 @@
+package git
+
+import (
+	"strings"
+)
+
+// AllAuthors는 작성자 필터를 끄는 값이다 (author: "*").
+const AllAuthors = "*"
+
+// coAuthorSeparator는 로그 포맷에서 Co-authored-by 트레일러 값들을 잇는 구분자이다.
+const coAuthorSeparator = "\x1f"
+
+// coAuthorsFormat은 Co-authored-by 트레일러 값을 coAuthorSeparator로 이어 한 줄로 출력하는 로그 포맷이다.
+const coAuthorsFormat = "%(trailers:key=Co-authored-by,valueonly,separator=%x1f)"
+
+// SplitAuthors는 "wook, me@example.com"처럼 쉼표로 이은 작성자 목록을 나눈다.
+func SplitAuthors(s string) []string {
+	var authors []string
+	for _, a := range strings.Split(s, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			authors = append(authors, a)
+		}
+	}
+	return authors
+}
+
+// repoAuthors는 레포에서 쓸 작성자 필터를 정한다. nil이면 필터하지 않는다.
+// 목록이 비어 있으면 그 레포의 user.email(없으면 user.name)을 쓰고, 둘 다 없거나
+// 목록에 AllAuthors가 있으면 전체이다.
+func repoAuthors(repoPath string, authors []string) []string {
+	for _, a := range authors {
+		if a == AllAuthors {
+			return nil
+		}
+	}
+	if len(authors) > 0 {
+		return authors
+	}
+
+	// "<me@example.com>"으로 감싸 다른 주소의 일부로 일치하지 않게 한다
+	if email := gitConfig(repoPath, "user.email"); email != "" {
+		return []string{"<" + email + ">"}
+	}
+	if name := gitConfig(repoPath, "user.name"); name != "" {
+		return []string{name}
+	}
+	return nil
+}
+
+// gitConfig는 레포에서 본 git 설정 값을 반환한다. 설정이 없으면 빈 문자열이다.
+func gitConfig(repoPath, key string) string {
+	out, err := runGit(repoPath, "config", "--get", key)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(out)
+}
+
+// matchAuthor는 커밋 작성자나 공동 작성자가 authors 중 하나와 일치하는지 확인한다.
+// git log --author처럼 "이름 <이메일>"의 일부와 비교하되 대소문자는 무시한다.
+// 작성자는 .mailmap을 적용한 이름과 원래 이름 둘 다 비교한다.
+func matchAuthor(c Commit, authors []string) bool {
+	return matchIdentity(append(c.authorIdentities(), c.CoAuthors...), authors)
+}
+
+// authorIdentities는 작성자의 "이름 <이메일>"이다. .mailmap을 적용한 값과 원래 값 둘 다 돌려준다.
+func (c Commit) authorIdentities() []string {
+	return []string{c.Author + " <" + c.Email + ">", c.rawAuthor}
+}
+
+// matchIdentity는 identities 중 하나가 patterns 중 하나를 (대소문자 무시하고) 포함하는지 확인한다.
+// 빈 패턴은 모든 작성자와 일치하므로 건너뛴다.
+func matchIdentity(identities, patterns []string) bool {
+	for _, p := range patterns {
+		p = strings.ToLower(p)
+		if p == "" {
+			continue
+		}
+		for _, id := range identities {
+			if id != "" && strings.Contains(strings.ToLower(id), p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// authoredCommits는 revs 범위에서 authors와 일치하는 커밋의 전체 해시를 git log 순서대로 반환한다.
+// 변경량 없이 작성자 정보만 읽으므로 빠르다. sources는 해시별 --source ref이다.
+func authoredCommits(repoPath string, revs, authors []string) (hashes []string, sources map[string]string, err error) {
+	format := "%H" + separator + "%S" + separator + "%aN" + separator + "%aE" + separator + "%an <%ae>" + separator + coAuthorsFormat
+	out, err := runGit(repoPath, append([]string{"log", "--format=" + format, "--source"}, revs...)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var commits []Commit
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		parts := strings.Split(line, separator)
+		if len(parts) < 6 {
+			continue
+		}
+		commits = append(commits, Commit{
+			Hash:      truncate(parts[0], 7),
+			fullHash:  parts[0],
+			Source:    parts[1],
+			Author:    parts[2],
+			Email:     parts[3],
+			rawAuthor: parts[4],
+			CoAuthors: parseCoAuthors(parts[5]),
+		})
+	}
+	if err := mapCoAuthors(repoPath, commits); err != nil {
+		return nil, nil, err
+	}
+
+	sources = make(map[string]string)
+	for _, c := range commits {
+		if matchAuthor(c, authors) {
+			hashes = append(hashes, c.fullHash)
+			sources[c.fullHash] = c.Source
+		}
+	}
+	return hashes, sources, nil
+}
+
+// mapCoAuthors는 Co-authored-by 트레일러의 공동 작성자에 레포의 .mailmap을 적용한다.
+// git log의 %aN/%aE와 달리 트레일러 값은 git이 바꿔주지 않아 git check-mailmap으로 한 번에 변환한다.
+func mapCoAuthors(repoPath string, commits []Commit) error {
+	seen := make(map[string]bool)
+	var contacts []string
+	for _, c := range commits {
+		for _, co := range c.CoAuthors {
+			// check-mailmap은 "이름 <이메일>" 형식이 아니면 실패한다
+			if !seen[co] && strings.Contains(co, "<") && strings.HasSuffix(co, ">") {
+				seen[co] = true
+				contacts = append(contacts, co)
+			}
+		}
+	}
+	if len(contacts) == 0 {
+		return nil
+	}
+
+	out, err := runGit(repoPath, append([]string{"check-mailmap"}, contacts...)...)
+	if err != nil {
+		return err
+	}
+	mapped := make(map[string]string, len(contacts))
+	for i, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if i < len(contacts) {
+			mapped[contacts[i]] = strings.TrimSpace(line)
+		}
+	}
+
+	for i := range commits {
+		for j, co := range commits[i].CoAuthors {
+			if m, ok := mapped[co]; ok {
+				commits[i].CoAuthors[j] = m
+			}
+		}
+	}
+	return nil
+}
+
+// parseCoAuthors는 %(trailers:...) 출력에서 공동 작성자 목록을 만든다.
+func parseCoAuthors(s string) []string {
+	var coAuthors []string
+	for _, co := range strings.Split(s, coAuthorSeparator) {
+		if co = strings.TrimSpace(co); co != "" {
+			coAuthors = append(coAuthors, co)
+		}
+	}
+	return coAuthors
+}
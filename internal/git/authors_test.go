@@ -0,0 +1,178 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestParseGitLog_Authors(t *testing.T) {
+	raw := "abc1234567890§§페어 작업§§Wook Kim§§2026-02-26T15:00:00+09:00§§refs/heads/main§§1111111§§wook@work.com§§wook <wook@old.com>§§" +
+		"Lee <lee@example.com>\x1fPark <park@example.com>"
+
+	commits, err := parseGitLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := commits[0]
+	if c.Author != "Wook Kim" || c.Email != "wook@work.com" || c.rawAuthor != "wook <wook@old.com>" {
+		t.Errorf("author = %q <%s> (raw %q)", c.Author, c.Email, c.rawAuthor)
+	}
+	if want := []string{"Lee <lee@example.com>", "Park <park@example.com>"}; !reflect.DeepEqual(c.CoAuthors, want) {
+		t.Errorf("co-authors = %q, want %q", c.CoAuthors, want)
+	}
+}
+
+func TestSplitAuthors(t *testing.T) {
+	got := SplitAuthors(" wook , me@gmail.com,, ")
+	if want := []string{"wook", "me@gmail.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitAuthors = %q, want %q", got, want)
+	}
+}
+
+func TestMatchAuthor(t *testing.T) {
+	c := Commit{
+		Author:    "Wook Kim",
+		Email:     "wook@work.com",
+		rawAuthor: "wook <12345+wook@users.noreply.github.com>",
+		CoAuthors: []string{"Lee <lee@example.com>"},
+	}
+	tests := []struct {
+		authors []string
+		want    bool
+	}{
+		{[]string{"wook kim"}, true},                            // 대소문자 무시
+		{[]string{"<wook@work.com>"}, true},                     // 이메일 전체
+		{[]string{"12345+wook@users.noreply.github.com"}, true}, // .mailmap 적용 전 이메일
+		{[]string{"lee@example.com"}, true},                     // 공동 작성자
+		{[]string{"park", "lee"}, true},                         // 하나만 일치하면 된다
+		{[]string{"<work.com>"}, false},
+		{[]string{"park"}, false},
+		{[]string{"", "park"}, false}, // 빈 패턴은 모두와 일치하지 않는다
+	}
+	for _, tt := range tests {
+		if got := matchAuthor(c, tt.authors); got != tt.want {
+			t.Errorf("matchAuthor(%q) = %v, want %v", tt.authors, got, tt.want)
+		}
+	}
+}
+
+// authorRepo는 세 개의 이메일로 작성한 커밋, 다른 사람의 커밋, 공동 작성 커밋이 있는 레포를 만든다.
+// .mailmap은 개인 이메일을 회사 이메일로 합친다.
+func authorRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q", "-b", "main")
+	gitIn(t, dir, "config", "user.email", "wook@work.com")
+	commit := func(name, email, msg string) {
+		os.WriteFile(filepath.Join(dir, "log.txt"), []byte(msg), 0644)
+		gitIn(t, dir, "add", "log.txt")
+		gitIn(t, dir, "-c", "user.name="+name, "-c", "user.email="+email, "commit", "-q", "-m", msg)
+	}
+	os.WriteFile(filepath.Join(dir, ".mailmap"), []byte(
+		"Wook Kim <wook@work.com> <wook@gmail.com>\n"), 0644)
+	commit("wook", "wook@work.com", "회사 이메일")
+	commit("wook", "wook@gmail.com", "개인 이메일")
+	commit("wook", "12345+wook@users.noreply.github.com", "GitHub 웹 편집")
+	commit("lee", "lee@example.com", "다른 사람")
+	commit("lee", "lee@example.com", "페어 작업\n\nCo-authored-by: wook <wook@gmail.com>")
+	return dir
+}
+
+func authorMessages(t *testing.T, dir string, authors []string) []string {
+	t.Helper()
+	now := time.Now()
+	results, err := CollectLogs([]Repo{{Path: dir, Kind: KindNormal}}, now.Add(-time.Hour), now.Add(time.Minute),
+		LogOptions{Authors: authors})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msgs []string
+	for _, r := range results {
+		for _, c := range r.Commits {
+			msgs = append(msgs, c.Message)
+		}
+	}
+	sort.Strings(msgs)
+	return msgs
+}
+
+func TestCollectLogs_Authors(t *testing.T) {
+	dir := authorRepo(t)
+
+	tests := []struct {
+		name    string
+		authors []string
+		want    []string
+	}{
+		// 기본값은 레포의 user.email이고, .mailmap으로 개인 이메일과 공동 작성 커밋도 잡힌다
+		{"default", nil, []string{"개인 이메일", "페어 작업", "회사 이메일"}},
+		{"identities", []string{"<wook@work.com>", "users.noreply.github.com"},
+			[]string{"GitHub 웹 편집", "개인 이메일", "페어 작업", "회사 이메일"}},
+		{"other", []string{"lee@example.com"}, []string{"다른 사람", "페어 작업"}},
+		{"all", []string{AllAuthors}, []string{"GitHub 웹 편집", "개인 이메일", "다른 사람", "페어 작업", "회사 이메일"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authorMessages(t, dir, tt.authors); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("commits = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectLogs_CoAuthorMailmap(t *testing.T) {
+	dir := authorRepo(t)
+	now := time.Now()
+	results, err := CollectLogs([]Repo{{Path: dir, Kind: KindNormal}}, now.Add(-time.Hour), now.Add(time.Minute),
+		LogOptions{Authors: []string{AllAuthors}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range results[0].Commits {
+		switch c.Message {
+		case "페어 작업":
+			if want := []string{"Wook Kim <wook@work.com>"}; !reflect.DeepEqual(c.CoAuthors, want) {
+				t.Errorf("co-authors = %q, want %q", c.CoAuthors, want)
+			}
+		case "개인 이메일":
+			if c.Author != "Wook Kim" || c.Email != "wook@work.com" {
+				t.Errorf("mailmap not applied: %q <%s>", c.Author, c.Email)
+			}
+		}
+	}
+}
+
+// 작성자로 거른 뒤 다시 읽은 커밋에도 변경량, ref, 공동 작성자가 그대로 있어야 한다
+func TestCollectLogs_AuthorsKeepDetails(t *testing.T) {
+	dir := authorRepo(t)
+	now := time.Now()
+	results, err := CollectLogs([]Repo{{Path: dir, Kind: KindNormal}}, now.Add(-time.Hour), now.Add(time.Minute),
+		LogOptions{Authors: []string{"lee@example.com"}, WithFiles: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	commits := results[0].Commits
+	if len(commits) != 2 {
+		t.Fatalf("expected 2 commits, got %d", len(commits))
+	}
+	for _, c := range commits {
+		if c.Files != 1 || len(c.Changes) != 1 || c.Source != "refs/heads/main" {
+			t.Errorf("%s: files=%d changes=%d source=%q", c.Message, c.Files, len(c.Changes), c.Source)
+		}
+		if c.Message != "페어 작업" {
+			continue
+		}
+		if want := []string{"Wook Kim <wook@work.com>"}; !reflect.DeepEqual(c.CoAuthors, want) {
+			t.Errorf("co-authors = %q, want %q", c.CoAuthors, want)
+		}
+	}
+}
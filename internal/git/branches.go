@@ -0,0 +1,179 @@
+package git
+
+import (
+	"os/exec"
+	"path"
+	"strings"
+	"time"
+)
+
+// reflogCommitPrefix는 브랜치 reflog에서 그 브랜치 위에서 커밋을 만들었다는 기록이다.
+// "commit:", "commit (amend):", "commit (merge):", "commit (initial):"이 모두 해당한다.
+const reflogCommitPrefix = "commit"
+
+// matchBranches는 브랜치 필터(glob)와 일치하는 로컬/원격 브랜치의 ref 이름을 반환한다.
+// 패턴은 짧은 이름(feature/login, origin/main)에 path.Match로 비교한다.
+func matchBranches(repoPath string, patterns []string) ([]string, error) {
+	out, err := runGit(repoPath, "for-each-ref", "--format=%(refname)", "refs/heads", "refs/remotes")
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []string
+	for _, ref := range strings.Fields(out) {
+		name := BranchName(ref)
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, name); ok {
+				refs = append(refs, ref)
+				break
+			}
+		}
+	}
+	return refs, nil
+}
+
+// annotateBranches는 커밋마다 그 커밋을 포함하는 로컬 브랜치(Branches)와 작성한 브랜치(Branch)를 채운다.
+//
+// Branch는 브랜치 reflog에 그 커밋의 commit 기록이 있으면 그 브랜치이다. reflog가 없으면
+// (clone해 온 커밋, bare 레포, 만료된 reflog) git log --source가 도달한 브랜치가 포함 브랜치 중에
+// 있으면 그것을, 아니면 포함 브랜치 중 기본 브랜치가 아닌 첫 번째를 쓴다.
+//
+// 브랜치 수와 관계없이 git을 세 번만 실행한다: 브랜치 끝 커밋(for-each-ref), 브랜치 reflog(log -g),
+// 그리고 since 이후 커밋을 자식부터 훑으며 포함 브랜치를 부모에게 물려주는 log --topo-order이다.
+func annotateBranches(repoPath string, commits []Commit, since time.Time) error {
+	out, err := runGit(repoPath, "for-each-ref", "--format=%(objectname) %(refname:short)", "refs/heads")
+	if err != nil {
+		return err
+	}
+	var branches []string
+	tips := make(map[string][]int)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		hash, name, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		tips[hash] = append(tips[hash], len(branches))
+		branches = append(branches, name)
+	}
+	if len(branches) == 0 {
+		return nil
+	}
+
+	// contains는 해시별 포함 브랜치 집합이다 (branches 인덱스의 비트셋)
+	words := (len(branches) + 63) / 64
+	contains := make(map[string][]uint64)
+	graph, err := runGit(repoPath, "log", "--branches", "--topo-order", "--format=%H %P",
+		"--since="+since.Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(strings.TrimSpace(graph), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		set := contains[fields[0]]
+		if set == nil {
+			set = make([]uint64, words)
+			contains[fields[0]] = set
+		}
+		for _, i := range tips[fields[0]] {
+			set[i/64] |= 1 << (i % 64)
+		}
+		for _, parent := range fields[1:] {
+			ps := contains[parent]
+			if ps == nil {
+				ps = make([]uint64, words)
+				contains[parent] = ps
+			}
+			for w := range ps {
+				ps[w] |= set[w]
+			}
+		}
+	}
+
+	// reflog가 없는 브랜치도 있으므로 실패는 무시한다
+	authored := make(map[string]string)
+	reflog, _ := runGit(repoPath, "log", "-g", "--branches", "--format=%H %gD %gs")
+	for _, line := range strings.Split(reflog, "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) < 3 || !strings.HasPrefix(fields[2], reflogCommitPrefix) {
+			continue
+		}
+		branch, _, _ := strings.Cut(fields[1], "@{")
+		if _, seen := authored[fields[0]]; !seen {
+			authored[fields[0]] = branch
+		}
+	}
+
+	for i := range commits {
+		c := &commits[i]
+		c.Branches = nil
+		for b, name := range branches {
+			if set := contains[c.fullHash]; set != nil && set[b/64]&(1<<(b%64)) != 0 {
+				c.Branches = append(c.Branches, name)
+			}
+		}
+		c.Branch = authored[c.fullHash]
+		c.branchFromReflog = c.Branch != ""
+		if c.Branch == "" {
+			c.Branch = guessBranch(c.Branches, BranchName(c.Source))
+		}
+	}
+	return nil
+}
+
+// defaultBranches는 작성 브랜치를 추정할 때 뒤로 미루는 통합 브랜치 이름이다.
+var defaultBranches = map[string]bool{"main": true, "master": true, "develop": true, "trunk": true}
+
+// guessBranch는 reflog 기록이 없는 커밋의 작성 브랜치를 추정한다.
+// 기능 브랜치를 통합 브랜치에 병합하면 두 브랜치 모두 커밋을 포함하므로 기능 브랜치 쪽을 고른다.
+func guessBranch(contains []string, source string) string {
+	for _, b := range contains {
+		if b == source && !defaultBranches[b] {
+			return b
+		}
+	}
+	for _, b := range contains {
+		if !defaultBranches[b] {
+			return b
+		}
+	}
+	if len(contains) > 0 {
+		return contains[0]
+	}
+	// 원격 브랜치에만 있는 커밋은 --source의 ref(origin/feature 등)를 그대로 쓴다
+	return source
+}
+
+// ticketBranch는 이슈 키를 찾아도 되는 작성 브랜치이다. 확실하지 않으면 빈 문자열이다.
+// --source의 ref나 추정한 브랜치는 그 브랜치가 생기기 전 main에서 만든 커밋도 가리킬 수 있으므로,
+// reflog로 확인했거나 기본 브랜치에 없는 커밋일 때만 쓴다. LogOptions.WithBranches가 아니면 항상 빈 문자열이다.
+func (c Commit) ticketBranch() string {
+	if c.branchFromReflog {
+		return c.Branch
+	}
+	for _, b := range c.Branches {
+		if defaultBranches[b] {
+			return ""
+		}
+	}
+	return c.Branch
+}
+
+// runGit은 repoPath에서 git을 실행하고 표준 출력을 반환한다.
+func runGit(repoPath string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoPath
+	out, err := cmd.Output()
+	return string(out), err
+}
+
+// runGitInput은 input을 표준 입력으로 넘겨 git을 실행한다 (--stdin).
+func runGitInput(repoPath, input string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoPath
+	cmd.Stdin = strings.NewReader(input)
+	out, err := cmd.Output()
+	return string(out), err
+}
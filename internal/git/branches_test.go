@@ -0,0 +1,213 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// gitIn은 테스트 레포에서 git을 실행한다. 작성자 설정이 없는 환경에서도 커밋할 수 있게 -c로 넘긴다.
+func gitIn(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=wook", "-c", "user.email=wook@example.com"}, args...)...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+}
+
+// branchRepo는 main에 커밋 2개, main에서 갈라진 feature/login에 커밋 2개가 있는 레포를 만든다.
+func branchRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q", "-b", "main")
+	// 작성자 필터 기본값(레포의 user.email)이 개발자 설정에 따라 달라지지 않게 고정한다
+	gitIn(t, dir, "config", "user.email", "wook@example.com")
+	commit := func(file, msg string) {
+		os.WriteFile(filepath.Join(dir, file), []byte(msg), 0644)
+		gitIn(t, dir, "add", file)
+		gitIn(t, dir, "commit", "-q", "-m", msg)
+	}
+	commit("a.txt", "main 1")
+	gitIn(t, dir, "checkout", "-q", "-b", "feature/login")
+	commit("b.txt", "PAY-7 로그인 폼")
+	commit("c.txt", "로그인 검증")
+	gitIn(t, dir, "checkout", "-q", "main")
+	commit("d.txt", "main 2")
+	return dir
+}
+
+func branchesByMessage(results []RepoResult) map[string]string {
+	m := make(map[string]string)
+	for _, r := range results {
+		for _, c := range r.Commits {
+			m[c.Message] = c.Branch
+		}
+	}
+	return m
+}
+
+func TestCollectLogs_WithBranches(t *testing.T) {
+	dir := branchRepo(t)
+	now := time.Now()
+	tickets, _ := NewTicketMatcher(DefaultTicketPatterns, DefaultTicketIgnore)
+
+	results, err := CollectLogs([]Repo{{Path: dir, Kind: KindNormal}}, now.Add(-time.Hour), now.Add(time.Minute),
+		LogOptions{WithBranches: true, Tickets: tickets})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"main 1":      "main", // feature/login도 포함하지만 reflog상 main에서 작성
+		"main 2":      "main",
+		"PAY-7 로그인 폼": "feature/login",
+		"로그인 검증":      "feature/login",
+	}
+	if got := branchesByMessage(results); !reflect.DeepEqual(got, want) {
+		t.Errorf("branches = %v, want %v", got, want)
+	}
+
+	for _, c := range results[0].Commits {
+		if c.Message == "main 1" && !reflect.DeepEqual(c.Branches, []string{"feature/login", "main"}) {
+			t.Errorf("main 1 contained in %q, want [feature/login main]", c.Branches)
+		}
+		if c.Message == "로그인 검증" && len(c.Tickets) != 0 {
+			t.Errorf("tickets = %q, want none (branch name has no key)", c.Tickets)
+		}
+	}
+
+	// 커밋 시각이 같은 초에 몰려 있어 브랜치 순서는 보지 않는다
+	groups, _ := GroupCommits(results, GroupByBranch)
+	keys, _ := groupKeys(groups)
+	sort.Strings(keys)
+	if want := []string{"feature/login", "main"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("group keys = %q, want %q", keys, want)
+	}
+}
+
+// 짧은 해시가 겹쳐도 브랜치는 전체 해시로 찾는다
+func TestAnnotateBranches_FullHash(t *testing.T) {
+	dir := branchRepo(t)
+	commits := make([]Commit, 2)
+	for i, ref := range []string{"main", "feature/login"} {
+		out, err := runGit(dir, "rev-parse", ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		commits[i] = Commit{Hash: "abc1234", fullHash: strings.TrimSpace(out)}
+	}
+
+	if err := annotateBranches(dir, commits, time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if commits[0].Branch != "main" || !reflect.DeepEqual(commits[0].Branches, []string{"main"}) {
+		t.Errorf("main tip = %q in %q, want main in [main]", commits[0].Branch, commits[0].Branches)
+	}
+	if commits[1].Branch != "feature/login" || !reflect.DeepEqual(commits[1].Branches, []string{"feature/login"}) {
+		t.Errorf("feature tip = %q in %q, want feature/login in [feature/login]", commits[1].Branch, commits[1].Branches)
+	}
+}
+
+func TestCollectLogs_BranchFilter(t *testing.T) {
+	dir := branchRepo(t)
+	now := time.Now()
+	repos := []Repo{{Path: dir, Kind: KindNormal}}
+
+	results, err := CollectLogs(repos, now.Add(-time.Hour), now.Add(time.Minute), LogOptions{Branches: []string{"feature/*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(results[0].Commits); got != 3 {
+		t.Errorf("feature/* commits = %d, want 3 (main 1 is reachable from feature/login)", got)
+	}
+
+	results, err = CollectLogs(repos, now.Add(-time.Hour), now.Add(time.Minute), LogOptions{Branches: []string{"release/*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 0 {
+		t.Errorf("repo without matching branch should have no results, got %d", len(results))
+	}
+}
+
+func TestGuessBranch(t *testing.T) {
+	tests := []struct {
+		contains []string
+		source   string
+		want     string
+	}{
+		{[]string{"feature/a", "main"}, "main", "feature/a"},
+		{[]string{"feature/a", "feature/b", "main"}, "feature/b", "feature/b"},
+		{[]string{"main"}, "main", "main"},
+		{nil, "origin/feature/c", "origin/feature/c"},
+	}
+	for _, tt := range tests {
+		if got := guessBranch(tt.contains, tt.source); got != tt.want {
+			t.Errorf("guessBranch(%q, %q) = %q, want %q", tt.contains, tt.source, got, tt.want)
+		}
+	}
+}
+
+// main에서 만든 커밋은 나중에 생긴 기능 브랜치가 포함하더라도 그 브랜치 이름의 키를 받지 않는다
+func TestCollectLogs_TicketsFromAuthoredBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	gitIn(t, dir, "init", "-q", "-b", "main")
+	gitIn(t, dir, "config", "user.email", "wook@example.com")
+	commit := func(file, msg string) {
+		os.WriteFile(filepath.Join(dir, file), []byte(msg), 0644)
+		gitIn(t, dir, "add", file)
+		gitIn(t, dir, "commit", "-q", "-m", msg)
+	}
+	commit("a.txt", "feat(api): add thing PAY-12")
+	gitIn(t, dir, "checkout", "-q", "-b", "feature/PAY-99")
+	commit("b.txt", "폼 추가")
+	gitIn(t, dir, "checkout", "-q", "main")
+	commit("c.txt", "main 2")
+
+	tickets, _ := NewTicketMatcher(DefaultTicketPatterns, DefaultTicketIgnore)
+	want := map[string][]string{
+		"feat(api): add thing PAY-12": {"PAY-12"},
+		"폼 추가":                        {"PAY-99"},
+		"main 2":                      nil,
+	}
+	check := func(name string, withBranches bool, want map[string][]string) {
+		t.Helper()
+		now := time.Now()
+		results, err := CollectLogs([]Repo{{Path: dir, Kind: KindNormal}}, now.Add(-time.Hour), now.Add(time.Minute),
+			LogOptions{WithBranches: withBranches, Tickets: tickets})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string][]string)
+		for _, c := range results[0].Commits {
+			got[c.Message] = c.Tickets
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: tickets = %q, want %q", name, got, want)
+		}
+	}
+
+	check("reflog", true, want)
+	// 작성 브랜치를 모르면 --source의 ref를 쓰지 않고 메시지에서만 찾는다
+	check("without branches", false, map[string][]string{
+		"feat(api): add thing PAY-12": {"PAY-12"},
+		"폼 추가":                        nil,
+		"main 2":                      nil,
+	})
+	// reflog가 없으면 작성 브랜치를 추정하지만, main에 있는 커밋은 브랜치 이름에서 키를 찾지 않는다
+	gitIn(t, dir, "reflog", "expire", "--expire=now", "--all")
+	check("no reflog", true, want)
+}
@@ -0,0 +1,49 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+)
+
+// conventionalHeader는 Conventional Commits 머리줄 "type(scope)!: subject"이다.
+var conventionalHeader = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^()]*)\))?(!)?:\s*(\S.*)$`)
+
+// Conventional은 Conventional Commits 형식으로 해석한 커밋 메시지이다.
+// 형식이 아닌 메시지는 zero value이다 (Type이 빈 문자열).
+type Conventional struct {
+	Type     string // feat, fix, chore ... (소문자)
+	Scope    string // 괄호 안의 범위. 없으면 빈 문자열
+	Breaking bool   // "feat!:" 또는 "BREAKING CHANGE:" 푸터
+	Subject  string // 머리줄에서 type/scope를 뺀 설명
+}
+
+// ParseConventional은 커밋 메시지를 Conventional Commits 형식으로 해석한다.
+// 첫 줄이 머리줄이고, 나머지 줄에서 BREAKING CHANGE 푸터를 찾는다.
+func ParseConventional(message string) (Conventional, bool) {
+	header, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	m := conventionalHeader.FindStringSubmatch(strings.TrimSpace(header))
+	if m == nil {
+		return Conventional{}, false
+	}
+
+	c := Conventional{
+		Type:     strings.ToLower(m[1]),
+		Scope:    strings.TrimSpace(m[2]),
+		Breaking: m[3] == "!",
+		Subject:  strings.TrimSpace(m[4]),
+	}
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			c.Breaking = true
+		}
+	}
+	return c, true
+}
+
+// Conventional은 커밋 메시지를 Conventional Commits 형식으로 해석한다.
+// 본문(Body)의 BREAKING CHANGE 푸터도 본다.
+// 형식이 아니면 zero value를 반환하므로 템플릿에서 {{.Conventional.Type}}처럼 쓸 수 있다.
+func (c Commit) Conventional() Conventional {
+	cc, _ := ParseConventional(c.Message + "\n" + c.Body)
+	return cc
+}
@@ -0,0 +1,37 @@
+package git
+
+import "testing"
+
+func TestParseConventional(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want Conventional
+		ok   bool
+	}{
+		{"feat: 로그인 추가", Conventional{Type: "feat", Subject: "로그인 추가"}, true},
+		{"fix(api): null 체크", Conventional{Type: "fix", Scope: "api", Subject: "null 체크"}, true},
+		{"Feat(UI)!: 테마 교체", Conventional{Type: "feat", Scope: "UI", Breaking: true, Subject: "테마 교체"}, true},
+		{"refactor!: 설정 구조 변경", Conventional{Type: "refactor", Breaking: true, Subject: "설정 구조 변경"}, true},
+		{"chore(deps):bump x", Conventional{Type: "chore", Scope: "deps", Subject: "bump x"}, true},
+		{"feat: 토큰 만료\n\nBREAKING CHANGE: 기존 토큰 무효", Conventional{Type: "feat", Breaking: true, Subject: "토큰 만료"}, true},
+		{"인벤토리 UI 개선", Conventional{}, false},
+		{"Merge branch 'main': conflict", Conventional{}, false},
+		{"fix: ", Conventional{}, false},
+		{"WIP", Conventional{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseConventional(tt.msg)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseConventional(%q) = %+v, %v; want %+v, %v", tt.msg, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestCommitConventional(t *testing.T) {
+	if got := (Commit{Message: "docs(readme): 설치 방법"}).Conventional(); got.Type != "docs" || got.Scope != "readme" {
+		t.Errorf("Conventional() = %+v", got)
+	}
+	if got := (Commit{Message: "그냥 커밋"}).Conventional(); got != (Conventional{}) {
+		t.Errorf("non-conventional message should give zero value, got %+v", got)
+	}
+}
@@ -0,0 +1,64 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// RepoError는 단일 레포에서 git 실행이 실패한 정보이다.
+type RepoError struct {
+	Path     string
+	Stderr   string // git이 남긴 stderr (앞뒤 공백 제거)
+	ExitCode int    // git 종료 코드. git을 실행조차 못 했으면 -1
+	Err      error
+}
+
+func (e *RepoError) Error() string {
+	msg := e.Stderr
+	if msg == "" {
+		msg = e.Err.Error()
+	}
+	if e.ExitCode >= 0 {
+		return fmt.Sprintf("%s: %s (exit %d)", e.Path, msg, e.ExitCode)
+	}
+	return fmt.Sprintf("%s: %s", e.Path, msg)
+}
+
+func (e *RepoError) Unwrap() error { return e.Err }
+
+// newRepoError는 exec 에러에서 stderr와 종료 코드를 뽑아 RepoError를 만든다.
+func newRepoError(path string, err error) *RepoError {
+	re := &RepoError{Path: path, ExitCode: -1, Err: err}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		re.ExitCode = exitErr.ExitCode()
+		re.Stderr = strings.TrimSpace(string(exitErr.Stderr))
+	}
+	return re
+}
+
+// CollectError는 CollectLogs에서 일부 레포가 실패했을 때 반환하는 집계 에러이다.
+// 성공한 레포의 결과는 에러와 함께 그대로 반환된다.
+type CollectError struct {
+	Errors []*RepoError
+}
+
+func (e *CollectError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	return i18n.T("err.git_failed", len(e.Errors))
+}
+
+func (e *CollectError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, re := range e.Errors {
+		errs[i] = re
+	}
+	return errs
+}
@@ -0,0 +1,64 @@
+package git
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCollectLogs_ReportsFailedRepos(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	// .git 디렉토리만 있고 내용이 없는 깨진 레포
+	tmp := t.TempDir()
+	broken := filepath.Join(tmp, "broken")
+	os.MkdirAll(filepath.Join(broken, ".git"), 0755)
+
+	repos := []Repo{{Path: broken, Kind: KindNormal}}
+	now := time.Now()
+	results, err := CollectLogs(repos, now.Add(-time.Hour), now, LogOptions{})
+
+	if len(results) != 0 {
+		t.Errorf("expected no results, got %d", len(results))
+	}
+
+	var collectErr *CollectError
+	if !errors.As(err, &collectErr) {
+		t.Fatalf("expected *CollectError, got %v", err)
+	}
+	if len(collectErr.Errors) != 1 {
+		t.Fatalf("expected 1 repo error, got %d", len(collectErr.Errors))
+	}
+
+	re := collectErr.Errors[0]
+	if re.Path != broken {
+		t.Errorf("path = %q, want %q", re.Path, broken)
+	}
+	if re.ExitCode <= 0 {
+		t.Errorf("exit code = %d, want > 0", re.ExitCode)
+	}
+	if re.Stderr == "" {
+		t.Error("stderr should be captured")
+	}
+}
+
+func TestRepoError_Error(t *testing.T) {
+	re := &RepoError{Path: "/repo", Stderr: "fatal: detected dubious ownership", ExitCode: 128}
+	if got := re.Error(); !strings.Contains(got, "dubious ownership") || !strings.Contains(got, "exit 128") {
+		t.Errorf("Error() = %q", got)
+	}
+
+	notFound := newRepoError("/repo", exec.ErrNotFound)
+	if notFound.ExitCode != -1 {
+		t.Errorf("exit code = %d, want -1", notFound.ExitCode)
+	}
+	if !errors.Is(notFound, exec.ErrNotFound) {
+		t.Error("RepoError should unwrap to the underlying error")
+	}
+}
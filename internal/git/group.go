@@ -0,0 +1,172 @@
+package git
+
+import (
+	"sort"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// 커밋 묶음 기준 (--group-by)
+const (
+	GroupByRepo   = "repo"   // 레포별 (기본)
+	GroupByType   = "type"   // Conventional Commits 유형별 (feat, fix ...)
+	GroupByScope  = "scope"  // Conventional Commits 범위별
+	GroupByTicket = "ticket" // 이슈 키별 (PAY-1234, #87)
+	GroupByBranch = "branch" // 레포 안에서 작성 브랜치별 (LogOptions.WithBranches 필요)
+)
+
+// GroupBreaking은 type으로 묶을 때 breaking change 커밋을 모으는 묶음의 Key이다.
+const GroupBreaking = "!"
+
+// typeOrder는 type 묶음의 출력 순서이다. 여기 없는 유형은 뒤에 이름순으로 붙는다.
+var typeOrder = []string{"feat", "fix", "perf", "refactor", "revert", "docs", "test", "build", "ci", "style", "chore"}
+
+// CommitGroup은 레포를 가로질러 같은 기준 값으로 묶은 커밋이다.
+type CommitGroup struct {
+	Key     string // 레포 이름, 유형, 범위, 티켓, 브랜치. 기준 값이 없는 커밋의 묶음은 빈 문자열
+	Repo    string // 레포 안에서만 묶는 기준(branch)일 때 그 레포 이름
+	Commits []GroupedCommit
+}
+
+// GroupedCommit은 묶음 안의 커밋과 그 커밋이 속한 레포 이름이다.
+type GroupedCommit struct {
+	Repo string
+	Commit
+}
+
+// CheckGroupBy는 묶음 기준 값이 올바른지 확인한다.
+func CheckGroupBy(by string) error {
+	switch by {
+	case "", GroupByRepo, GroupByType, GroupByScope, GroupByTicket, GroupByBranch:
+		return nil
+	}
+	return i18n.Errorf("err.group_by", by)
+}
+
+// GroupCommits는 커밋을 기준(by)에 따라 묶는다. 묶음 안의 커밋은 results의 순서를 따른다.
+//
+// type은 breaking change 묶음(GroupBreaking)을 맨 앞에, typeOrder 순서의 유형을 그 다음에,
+// Conventional Commits 형식이 아닌 커밋(Key "")을 맨 뒤에 둔다. scope는 범위 이름순이고
+// 범위가 없는 커밋이 맨 뒤이다. ticket은 키 순서(CompareTickets)이고, 티켓이 여러 개인 커밋은
+// 각 묶음에 모두 들어간다. branch는 레포를 가로지르지 않고 레포마다 작성 브랜치별로 묶으며,
+// 레포 안에서는 커밋 순서상 먼저 나온 브랜치가 앞이다. repo는 레포마다 한 묶음이다.
+func GroupCommits(results []RepoResult, by string) ([]CommitGroup, error) {
+	if err := CheckGroupBy(by); err != nil {
+		return nil, err
+	}
+
+	var keysOf func(c Commit) []string
+	switch by {
+	case GroupByType:
+		keysOf = func(c Commit) []string {
+			cc := c.Conventional()
+			if cc.Breaking {
+				return []string{GroupBreaking}
+			}
+			return []string{cc.Type}
+		}
+	case GroupByScope:
+		keysOf = func(c Commit) []string { return []string{c.Conventional().Scope} }
+	case GroupByTicket:
+		keysOf = func(c Commit) []string {
+			if len(c.Tickets) == 0 {
+				return []string{""}
+			}
+			return c.Tickets
+		}
+	case GroupByBranch:
+		var groups []CommitGroup
+		for _, r := range results {
+			index := make(map[string]int)
+			for _, c := range r.Commits {
+				i, ok := index[c.Branch]
+				if !ok {
+					i = len(groups)
+					index[c.Branch] = i
+					groups = append(groups, CommitGroup{Key: c.Branch, Repo: r.Name})
+				}
+				groups[i].Commits = append(groups[i].Commits, GroupedCommit{Repo: r.Name, Commit: c})
+			}
+		}
+		return groups, nil
+	default:
+		groups := make([]CommitGroup, 0, len(results))
+		for _, r := range results {
+			g := CommitGroup{Key: r.Name}
+			for _, c := range r.Commits {
+				g.Commits = append(g.Commits, GroupedCommit{Repo: r.Name, Commit: c})
+			}
+			groups = append(groups, g)
+		}
+		return groups, nil
+	}
+
+	index := make(map[string]int)
+	var groups []CommitGroup
+	for _, r := range results {
+		for _, c := range r.Commits {
+			for _, key := range keysOf(c) {
+				i, ok := index[key]
+				if !ok {
+					i = len(groups)
+					index[key] = i
+					groups = append(groups, CommitGroup{Key: key})
+				}
+				groups[i].Commits = append(groups[i].Commits, GroupedCommit{Repo: r.Name, Commit: c})
+			}
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		ri, rj := groupRank(groups[i].Key, by), groupRank(groups[j].Key, by)
+		if ri != rj {
+			return ri < rj
+		}
+		if by == GroupByTicket {
+			return CompareTickets(groups[i].Key, groups[j].Key) < 0
+		}
+		return groups[i].Key < groups[j].Key
+	})
+	return groups, nil
+}
+
+// GroupedMessage는 묶음 제목과 겹치는 부분을 뺀 커밋 메시지이다.
+// type 묶음에서는 "scope: subject", scope 묶음에서는 "type: subject"로 보여준다.
+// breaking 묶음과 형식이 아닌 커밋은 원래 메시지 그대로이다.
+func GroupedMessage(c Commit, key, by string) string {
+	cc := c.Conventional()
+	if cc.Type == "" || by == GroupByType && key == GroupBreaking {
+		return c.Message
+	}
+	switch by {
+	case GroupByType:
+		if cc.Scope != "" {
+			return cc.Scope + ": " + cc.Subject
+		}
+		return cc.Subject
+	case GroupByScope:
+		if cc.Breaking {
+			return cc.Type + "!: " + cc.Subject
+		}
+		return cc.Type + ": " + cc.Subject
+	}
+	return c.Message
+}
+
+// groupRank는 묶음 정렬의 1차 기준이다. 같은 순위끼리는 Key 이름순이다.
+func groupRank(key, by string) int {
+	switch {
+	case key == "":
+		return len(typeOrder) + 2
+	case by != GroupByType:
+		return 0
+	case key == GroupBreaking:
+		return -1
+	}
+	for i, t := range typeOrder {
+		if key == t {
+			return i
+		}
+	}
+	return len(typeOrder) + 1
+}
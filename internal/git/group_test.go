@@ -0,0 +1,98 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func groupFixture() []RepoResult {
+	return []RepoResult{
+		{Name: "api", Commits: []Commit{
+			{Hash: "a1", Message: "feat(auth): 토큰 갱신"},
+			{Hash: "a2", Message: "fix: 타임아웃"},
+			{Hash: "a3", Message: "README 정리"},
+		}},
+		{Name: "web", Commits: []Commit{
+			{Hash: "w1", Message: "chore(deps): 의존성 업데이트"},
+			{Hash: "w2", Message: "feat(auth)!: 로그인 화면 교체"},
+			{Hash: "w3", Message: "feat: 다크 모드"},
+			{Hash: "w4", Message: "wip(ui): 실험"},
+		}},
+	}
+}
+
+func groupKeys(groups []CommitGroup) (keys []string, hashes [][]string) {
+	for _, g := range groups {
+		keys = append(keys, g.Key)
+		var hs []string
+		for _, c := range g.Commits {
+			hs = append(hs, c.Repo+"/"+c.Hash)
+		}
+		hashes = append(hashes, hs)
+	}
+	return keys, hashes
+}
+
+func TestGroupCommits_Type(t *testing.T) {
+	groups, err := GroupCommits(groupFixture(), GroupByType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, hashes := groupKeys(groups)
+
+	wantKeys := []string{GroupBreaking, "feat", "fix", "chore", "wip", ""}
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("keys = %q, want %q", keys, wantKeys)
+	}
+	if want := []string{"api/a1", "web/w3"}; !reflect.DeepEqual(hashes[1], want) {
+		t.Errorf("feat = %q, want %q (breaking commit only in its own group)", hashes[1], want)
+	}
+}
+
+func TestGroupCommits_Scope(t *testing.T) {
+	groups, err := GroupCommits(groupFixture(), GroupByScope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, hashes := groupKeys(groups)
+
+	if want := []string{"auth", "deps", "ui", ""}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %q, want %q", keys, want)
+	}
+	if want := []string{"api/a1", "web/w2"}; !reflect.DeepEqual(hashes[0], want) {
+		t.Errorf("auth = %q, want %q", hashes[0], want)
+	}
+}
+
+func TestGroupCommits_Repo(t *testing.T) {
+	groups, err := GroupCommits(groupFixture(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys, _ := groupKeys(groups); !reflect.DeepEqual(keys, []string{"api", "web"}) {
+		t.Errorf("keys = %q", keys)
+	}
+}
+
+func TestGroupCommits_Invalid(t *testing.T) {
+	if _, err := GroupCommits(groupFixture(), "author"); err == nil {
+		t.Error("expected error for unknown group-by")
+	}
+}
+
+func TestGroupedMessage(t *testing.T) {
+	tests := []struct {
+		msg, key, by, want string
+	}{
+		{"feat(auth): 토큰 갱신", "feat", GroupByType, "auth: 토큰 갱신"},
+		{"feat: 다크 모드", "feat", GroupByType, "다크 모드"},
+		{"feat(auth)!: 로그인 화면 교체", GroupBreaking, GroupByType, "feat(auth)!: 로그인 화면 교체"},
+		{"feat(auth)!: 로그인 화면 교체", "auth", GroupByScope, "feat!: 로그인 화면 교체"},
+		{"README 정리", "", GroupByScope, "README 정리"},
+	}
+	for _, tt := range tests {
+		if got := GroupedMessage(Commit{Message: tt.msg}, tt.key, tt.by); got != tt.want {
+			t.Errorf("GroupedMessage(%q, %q, %q) = %q, want %q", tt.msg, tt.key, tt.by, got, tt.want)
+		}
+	}
+}
@@ -1,8 +1,7 @@
 package git
 
 import (
-	"os/exec"
-	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -11,91 +10,294 @@ import (
 
 // Commit은 단일 커밋 정보를 나타낸다.
 type Commit struct {
-	Hash    string
-	Message string
-	Author  string
-	Date    time.Time
-	Files   int // 변경된 파일 수
+	Hash       string
+	Message    string // 제목 줄 (%s)
+	Body       string // 제목 줄을 뺀 본문 (%b). BREAKING CHANGE 푸터를 찾는 데 쓴다
+	Author     string // .mailmap을 적용한 이름 (%aN)
+	Email      string // .mailmap을 적용한 이메일 (%aE)
+	Date       time.Time
+	Files      int  // 변경된 파일 수
+	Insertions int  // 추가된 라인 수
+	Deletions  int  // 삭제된 라인 수
+	Merge      bool // 부모가 둘 이상인 병합 커밋
+
+	// CoAuthors는 Co-authored-by 트레일러의 공동 작성자이다 ("이름 <이메일>", .mailmap 적용).
+	CoAuthors []string
+	// rawAuthor는 .mailmap을 적용하기 전의 "이름 <이메일>"이다. 작성자 필터에만 쓴다.
+	rawAuthor string
+	// fullHash는 줄이지 않은 해시이다. 짧은 Hash는 겹칠 수 있어 브랜치 조회 등에는 이것을 쓴다.
+	fullHash string
+	// branchFromReflog는 Branch를 추정이 아니라 브랜치 reflog의 commit 기록으로 확인했는지 여부이다.
+	branchFromReflog bool
+
+	// Source는 git log --all --source가 이 커밋에 도달한 ref이다 (예: refs/heads/feature/login).
+	Source string
+	// Tickets는 메시지와 작성 브랜치 이름에서 찾은 이슈 키이다. 브랜치 이름은 LogOptions.WithBranches이고
+	// 그 브랜치에서 작성한 것이 확실할 때만 본다. LogOptions.Tickets일 때만 채워진다.
+	Tickets []string
+
+	// Branch는 커밋을 작성한 브랜치, Branches는 커밋을 포함하는 로컬 브랜치이다.
+	// LogOptions.WithBranches일 때만 채워진다.
+	Branch   string
+	Branches []string
+
+	// Changes는 파일별 변경 내역이다. LogOptions.WithFiles일 때만 채워진다.
+	Changes []FileChange
+}
+
+// FileChange는 커밋 안에서 변경된 단일 파일 정보이다 (--numstat).
+type FileChange struct {
+	Path       string
+	Insertions int
+	Deletions  int
+	Binary     bool // 바이너리 파일은 라인 수를 알 수 없다
+}
+
+// LogOptions는 커밋 로그 수집 옵션이다.
+type LogOptions struct {
+	// Authors는 작성자 필터이다. 이름이나 이메일의 일부와 비교하고 공동 작성자도 포함한다.
+	// 비워두면 레포마다 user.email(없으면 user.name)을 쓰고, AllAuthors("*")면 전체이다.
+	Authors   []string
+	WithFiles bool // --numstat으로 파일별 변경 내역 수집
+
+	Tickets *TicketMatcher // 메시지/브랜치에서 이슈 키 추출 (nil이면 추출하지 않음)
+
+	NoMerges     bool     // 병합 커밋 제외 (git log --no-merges)
+	WithBranches bool     // 커밋별 작성/포함 브랜치 수집 (Commit.Branch, Commit.Branches)
+	Branches     []string // 이 브랜치(glob)에서 도달하는 커밋만 수집. 비우면 모든 ref(--all)
 }
 
 // RepoResult는 단일 레포의 커밋 수집 결과이다.
 type RepoResult struct {
-	Name    string
-	Path    string
-	Commits []Commit
+	Name      string
+	Path      string
+	Kind      RepoKind
+	Branch    string
+	Worktrees []Worktree
+	Commits   []Commit
+
+	// Merges는 merges: collapse로 Commits에서 뗀 병합 커밋이다 (ApplyMerges).
+	Merges []Commit
+}
+
+// Label은 레포 형태/워크트리를 나타내는 짧은 표시를 반환한다. 일반 레포는 빈 문자열이다.
+func (r RepoResult) Label() string {
+	if len(r.Worktrees) > 1 {
+		branches := make([]string, len(r.Worktrees))
+		for i, wt := range r.Worktrees {
+			branches[i] = wt.Branch
+		}
+		return "worktrees: " + strings.Join(branches, ", ")
+	}
+
+	switch r.Kind {
+	case KindWorktree:
+		return "worktree: " + r.Branch
+	case KindSubmodule, KindBare:
+		return string(r.Kind)
+	}
+	return ""
+}
+
+// TotalFiles는 레포 전체 커밋의 변경 파일 수 합계를 반환한다.
+func (r RepoResult) TotalFiles() int {
+	n := 0
+	for _, c := range r.Commits {
+		n += c.Files
+	}
+	return n
+}
+
+// TotalInsertions는 레포 전체 커밋의 추가 라인 수 합계를 반환한다.
+func (r RepoResult) TotalInsertions() int {
+	n := 0
+	for _, c := range r.Commits {
+		n += c.Insertions
+	}
+	return n
+}
+
+// TotalDeletions는 레포 전체 커밋의 삭제 라인 수 합계를 반환한다.
+func (r RepoResult) TotalDeletions() int {
+	n := 0
+	for _, c := range r.Commits {
+		n += c.Deletions
+	}
+	return n
+}
+
+// LastCommit은 레포에서 가장 최근 커밋 시각을 반환한다. 커밋이 없으면 zero time이다.
+func (r RepoResult) LastCommit() time.Time {
+	var last time.Time
+	for _, c := range r.Commits {
+		if c.Date.After(last) {
+			last = c.Date
+		}
+	}
+	return last
 }
 
 // CollectLogs는 여러 레포에서 병렬로 커밋 로그를 수집한다.
-func CollectLogs(repos []string, since, until time.Time, author string) ([]RepoResult, error) {
+// 결과는 레포 이름순, 커밋은 최신순으로 정렬되어 실행마다 순서가 같다.
+// 일부 레포에서 git이 실패하면 나머지 결과와 함께 *CollectError를 반환한다.
+func CollectLogs(repos []Repo, since, until time.Time, opts LogOptions) ([]RepoResult, error) {
 	var (
 		mu      sync.Mutex
 		wg      sync.WaitGroup
 		results []RepoResult
+		failed  []*RepoError
 	)
 
 	for _, repo := range repos {
 		wg.Add(1)
-		go func(repoPath string) {
+		go func(repo Repo) {
 			defer wg.Done()
 
-			commits, err := getCommits(repoPath, since, until, author)
-			if err != nil || len(commits) == 0 {
+			commits, err := getCommits(repo.Path, since, until, opts)
+			if err != nil {
+				mu.Lock()
+				failed = append(failed, newRepoError(repo.Path, err))
+				mu.Unlock()
+				return
+			}
+			if len(commits) == 0 {
 				return
 			}
 
 			mu.Lock()
 			results = append(results, RepoResult{
-				Name:    filepath.Base(repoPath),
-				Path:    repoPath,
-				Commits: commits,
+				Name:      repo.Name(),
+				Path:      repo.Path,
+				Kind:      repo.Kind,
+				Branch:    repo.Branch,
+				Worktrees: repo.Worktrees,
+				Commits:   commits,
 			})
 			mu.Unlock()
 		}(repo)
 	}
 
 	wg.Wait()
+
+	SortResults(results, SortByName)
+	SortCommits(results, OrderDesc)
+
+	if len(failed) > 0 {
+		sort.Slice(failed, func(i, j int) bool { return failed[i].Path < failed[j].Path })
+		return results, &CollectError{Errors: failed}
+	}
 	return results, nil
 }
 
 const separator = "§§"
 
-func getCommits(repoPath string, since, until time.Time, author string) ([]Commit, error) {
-	format := "%H" + separator + "%s" + separator + "%an" + separator + "%aI"
-	args := []string{
-		"log",
-		"--all",
-		"--format=" + format,
+// bodyEnd는 로그 포맷에서 여러 줄인 본문(%b)의 끝을 표시한다.
+const bodyEnd = "\x1e"
+
+func getCommits(repoPath string, since, until time.Time, opts LogOptions) ([]Commit, error) {
+	format := "%H" + separator + "%s" + separator + "%aN" + separator + "%aI" + separator + "%S" + separator + "%P" +
+		separator + "%aE" + separator + "%an <%ae>" + separator + coAuthorsFormat +
+		separator + "%b%x1e"
+	args := []string{"log", "--format=" + format, "--shortstat"}
+	if opts.WithFiles {
+		args = append(args, "--numstat")
+	}
+
+	revs := []string{
 		"--since=" + since.Format(time.RFC3339),
 		"--until=" + until.Format(time.RFC3339),
-		"--shortstat",
+	}
+	if opts.NoMerges {
+		revs = append(revs, "--no-merges")
+	}
+	if len(opts.Branches) == 0 {
+		revs = append(revs, "--all")
+	} else {
+		refs, err := matchBranches(repoPath, opts.Branches)
+		if err != nil {
+			return nil, err
+		}
+		// 필터와 일치하는 브랜치가 없는 레포는 커밋이 없는 것으로 본다
+		if len(refs) == 0 {
+			return nil, nil
+		}
+		revs = append(revs, refs...)
 	}
 
-	if author != "" {
-		args = append(args, "--author="+author)
+	var out string
+	var sources map[string]string
+	if authors := repoAuthors(repoPath, opts.Authors); authors == nil {
+		var err error
+		out, err = runGit(repoPath, append(append(args, "--source"), revs...)...)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// 변경량(--shortstat/--numstat) 계산이 비싸므로 작성자 정보만 먼저 읽어 거른 뒤
+		// 남은 커밋만 다시 읽는다. 공동 작성자와 .mailmap 전후 이름까지 봐야 해서 git log --author는 쓰지 않는다
+		hashes, refs, err := authoredCommits(repoPath, revs, authors)
+		if err != nil {
+			return nil, err
+		}
+		if len(hashes) == 0 {
+			return nil, nil
+		}
+		out, err = runGitInput(repoPath, strings.Join(hashes, "\n")+"\n", append(args, "--no-walk=unsorted", "--stdin")...)
+		if err != nil {
+			return nil, err
+		}
+		sources = refs
 	}
 
-	cmd := exec.Command("git", args...)
-	cmd.Dir = repoPath
-	out, err := cmd.Output()
+	commits, err := parseGitLog(out)
 	if err != nil {
 		return nil, err
 	}
-
-	return parseGitLog(string(out))
+	if err := mapCoAuthors(repoPath, commits); err != nil {
+		return nil, err
+	}
+	if sources != nil {
+		for i := range commits {
+			commits[i].Source = sources[commits[i].fullHash]
+		}
+	}
+	if opts.WithBranches && len(commits) > 0 {
+		if err := annotateBranches(repoPath, commits, since); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Tickets != nil {
+		for i := range commits {
+			c := &commits[i]
+			c.Tickets = opts.Tickets.Find(c.Message, c.ticketBranch())
+		}
+	}
+	return commits, nil
 }
 
 func parseGitLog(raw string) ([]Commit, error) {
 	var commits []Commit
 	lines := strings.Split(strings.TrimSpace(raw), "\n")
 
-	var current *Commit
+	var current, inBody *Commit
 	for _, line := range lines {
+		// 본문은 여러 줄이라 bodyEnd가 나올 때까지 이어 붙인다
+		if inBody != nil {
+			text, done := strings.CutSuffix(strings.TrimRight(line, " \t\r"), bodyEnd)
+			inBody.Body += "\n" + text
+			if done {
+				inBody.Body = strings.TrimSpace(inBody.Body)
+				inBody = nil
+			}
+			continue
+		}
+
 		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
 		}
 
-		// 커밋 라인: hash§§message§§author§§date
+		// 커밋 라인: hash§§message§§author§§date§§source§§parents§§email§§raw author§§co-authors§§body
 		if strings.Contains(line, separator) {
 			parts := strings.Split(line, separator)
 			if len(parts) < 4 {
@@ -104,19 +306,47 @@ func parseGitLog(raw string) ([]Commit, error) {
 
 			date, _ := time.Parse(time.RFC3339, parts[3])
 			c := Commit{
-				Hash:    truncate(parts[0], 7),
-				Message: parts[1],
-				Author:  parts[2],
-				Date:    date,
+				Hash:     truncate(parts[0], 7),
+				fullHash: parts[0],
+				Message:  parts[1],
+				Author:   parts[2],
+				Date:     date,
+			}
+			if len(parts) > 4 {
+				c.Source = parts[4]
+			}
+			if len(parts) > 5 {
+				c.Merge = len(strings.Fields(parts[5])) > 1
+			}
+			if len(parts) > 8 {
+				c.Email = parts[6]
+				c.rawAuthor = parts[7]
+				c.CoAuthors = parseCoAuthors(parts[8])
+			}
+			body, done := "", true
+			if len(parts) > 9 {
+				body, done = strings.CutSuffix(strings.Join(parts[9:], separator), bodyEnd)
+				c.Body = strings.TrimSpace(body)
 			}
 			commits = append(commits, c)
 			current = &commits[len(commits)-1]
+			if !done {
+				inBody = current
+			}
+			continue
+		}
+
+		// numstat 라인: "45\t12\tpath/to/file" (바이너리는 "-\t-\tpath")
+		if current != nil && strings.Contains(line, "\t") {
+			if fc, ok := parseNumStat(line); ok {
+				current.Changes = append(current.Changes, fc)
+			}
 			continue
 		}
 
 		// shortstat 라인: " 3 files changed, 45 insertions(+), 12 deletions(-)"
 		if current != nil && strings.Contains(line, "file") {
-			current.Files = parseFileCount(line)
+			current.Files, current.Insertions, current.Deletions = parseShortStat(line)
 			current = nil
 		}
 	}
@@ -131,13 +361,68 @@ func truncate(s string, n int) string {
 	return s[:n]
 }
 
-func parseFileCount(stat string) int {
-	parts := strings.Fields(stat)
-	if len(parts) > 0 {
-		n, err := strconv.Atoi(parts[0])
-		if err == nil {
-			return n
+// parseShortStat은 shortstat 라인에서 파일 수, 추가/삭제 라인 수를 추출한다.
+// insertions/deletions 항목은 0이면 git이 생략하므로 없을 수 있다.
+func parseShortStat(stat string) (files, insertions, deletions int) {
+	for _, part := range strings.Split(stat, ",") {
+		fields := strings.Fields(part)
+		if len(fields) < 2 {
+			continue
 		}
+		n, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(fields[1], "file"):
+			files = n
+		case strings.HasPrefix(fields[1], "insertion"):
+			insertions = n
+		case strings.HasPrefix(fields[1], "deletion"):
+			deletions = n
+		}
+	}
+	return files, insertions, deletions
+}
+
+// parseNumStat은 numstat 라인 하나를 FileChange로 변환한다.
+func parseNumStat(line string) (FileChange, bool) {
+	parts := strings.SplitN(line, "\t", 3)
+	if len(parts) != 3 {
+		return FileChange{}, false
 	}
-	return 0
+
+	fc := FileChange{Path: renamedPath(parts[2])}
+	if parts[0] == "-" && parts[1] == "-" {
+		fc.Binary = true
+		return fc, true
+	}
+
+	ins, err1 := strconv.Atoi(parts[0])
+	del, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return FileChange{}, false
+	}
+	fc.Insertions = ins
+	fc.Deletions = del
+	return fc, true
+}
+
+// renamedPath는 numstat의 이름 변경 표기에서 새 경로를 돌려준다.
+// "old => new", "dir/{old => new}/file" 두 형태를 처리한다.
+func renamedPath(path string) string {
+	if !strings.Contains(path, " => ") {
+		return path
+	}
+
+	start := strings.Index(path, "{")
+	end := strings.Index(path, "}")
+	if start >= 0 && end > start {
+		_, after, _ := strings.Cut(path[start+1:end], " => ")
+		joined := path[:start] + after + path[end+1:]
+		return strings.TrimPrefix(strings.ReplaceAll(joined, "//", "/"), "/")
+	}
+
+	_, after, _ := strings.Cut(path, " => ")
+	return after
 }
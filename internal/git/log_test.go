@@ -1,6 +1,7 @@
 package git
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -35,6 +36,9 @@ def7890123456§§두 번째 커밋§§wook§§2026-02-26T16:00:00+09:00
 	if c.Files != 3 {
 		t.Errorf("files = %d, want 3", c.Files)
 	}
+	if c.Insertions != 100 || c.Deletions != 20 {
+		t.Errorf("churn = +%d -%d, want +100 -20", c.Insertions, c.Deletions)
+	}
 
 	// 두 번째 커밋
 	c2 := commits[1]
@@ -44,6 +48,59 @@ def7890123456§§두 번째 커밋§§wook§§2026-02-26T16:00:00+09:00
 	if c2.Files != 1 {
 		t.Errorf("files = %d, want 1", c2.Files)
 	}
+	if c2.Insertions != 10 || c2.Deletions != 0 {
+		t.Errorf("churn = +%d -%d, want +10 -0", c2.Insertions, c2.Deletions)
+	}
+}
+
+func TestParseGitLog_Source(t *testing.T) {
+	raw := `abc1234567890§§PAY-12 결제 취소§§wook§§2026-02-26T15:00:00+09:00§§refs/heads/feature/PAY-12
+
+ 1 file changed, 3 insertions(+)`
+
+	commits, err := parseGitLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("expected 1 commit, got %d", len(commits))
+	}
+	if commits[0].Source != "refs/heads/feature/PAY-12" {
+		t.Errorf("source = %q", commits[0].Source)
+	}
+}
+
+func TestParseGitLog_Body(t *testing.T) {
+	raw := "abc1234567890§§feat(api): 토큰 갱신 방식 변경§§wook§§2026-02-26T15:00:00+09:00§§refs/heads/main§§1111111§§wook@work.com§§wook <wook@work.com>§§§§" +
+		"refresh 토큰을 쿠키로 옮긴다.\n" +
+		"2 files changed 같은 문장도 본문이다.\n\n" +
+		"BREAKING CHANGE: /auth/refresh가 본문 대신 쿠키를 읽는다\n\x1e\n" +
+		"\n 1 file changed, 3 insertions(+)\n" +
+		"def7890123456§§fix: 오타§§wook§§2026-02-26T16:00:00+09:00§§refs/heads/main§§abc1234§§wook@work.com§§wook <wook@work.com>§§§§\x1e\n" +
+		"\n 1 file changed, 1 insertion(+)"
+
+	commits, err := parseGitLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 2 {
+		t.Fatalf("expected 2 commits, got %d", len(commits))
+	}
+
+	c := commits[0]
+	if c.Files != 1 || c.Insertions != 3 {
+		t.Errorf("stat = %d files +%d, want 1 files +3", c.Files, c.Insertions)
+	}
+	if want := "BREAKING CHANGE: /auth/refresh가 본문 대신 쿠키를 읽는다"; !strings.HasSuffix(c.Body, want) {
+		t.Errorf("body = %q, want suffix %q", c.Body, want)
+	}
+	if cc := c.Conventional(); !cc.Breaking || cc.Subject != "토큰 갱신 방식 변경" {
+		t.Errorf("conventional = %+v, want breaking from footer", cc)
+	}
+
+	if c2 := commits[1]; c2.Body != "" || c2.Conventional().Breaking || c2.Insertions != 1 {
+		t.Errorf("second commit = body %q, breaking %v, +%d", c2.Body, c2.Conventional().Breaking, c2.Insertions)
+	}
 }
 
 func TestParseGitLog_Empty(t *testing.T) {
@@ -56,19 +113,99 @@ func TestParseGitLog_Empty(t *testing.T) {
 	}
 }
 
-func TestParseFileCount(t *testing.T) {
+func TestParseShortStat(t *testing.T) {
+	tests := []struct {
+		input      string
+		files      int
+		insertions int
+		deletions  int
+	}{
+		{"3 files changed, 100 insertions(+), 20 deletions(-)", 3, 100, 20},
+		{"1 file changed, 10 insertions(+)", 1, 10, 0},
+		{"2 files changed, 5 deletions(-)", 2, 0, 5},
+		{"1 file changed, 1 insertion(+), 1 deletion(-)", 1, 1, 1},
+		{"", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		files, ins, del := parseShortStat(tt.input)
+		if files != tt.files || ins != tt.insertions || del != tt.deletions {
+			t.Errorf("parseShortStat(%q) = (%d, %d, %d), want (%d, %d, %d)",
+				tt.input, files, ins, del, tt.files, tt.insertions, tt.deletions)
+		}
+	}
+}
+
+func TestRepoResultTotals(t *testing.T) {
+	r := RepoResult{
+		Commits: []Commit{
+			{Files: 3, Insertions: 100, Deletions: 20},
+			{Files: 1, Insertions: 10},
+		},
+	}
+
+	if got := r.TotalFiles(); got != 4 {
+		t.Errorf("TotalFiles() = %d, want 4", got)
+	}
+	if got := r.TotalInsertions(); got != 110 {
+		t.Errorf("TotalInsertions() = %d, want 110", got)
+	}
+	if got := r.TotalDeletions(); got != 20 {
+		t.Errorf("TotalDeletions() = %d, want 20", got)
+	}
+}
+
+func TestParseGitLog_NumStat(t *testing.T) {
+	raw := `abc1234567890§§파일 변경§§wook§§2026-02-26T15:00:00+09:00
+
+10	2	internal/git/log.go
+-	-	assets/logo.png
+1	0	docs/{old.md => new.md}
+ 3 files changed, 11 insertions(+), 2 deletions(-)`
+
+	commits, err := parseGitLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("expected 1 commit, got %d", len(commits))
+	}
+
+	c := commits[0]
+	if c.Files != 3 || c.Insertions != 11 || c.Deletions != 2 {
+		t.Errorf("shortstat = (%d, +%d -%d), want (3, +11 -2)", c.Files, c.Insertions, c.Deletions)
+	}
+	if len(c.Changes) != 3 {
+		t.Fatalf("expected 3 changes, got %d", len(c.Changes))
+	}
+
+	want := []FileChange{
+		{Path: "internal/git/log.go", Insertions: 10, Deletions: 2},
+		{Path: "assets/logo.png", Binary: true},
+		{Path: "docs/new.md", Insertions: 1},
+	}
+	for i, w := range want {
+		if c.Changes[i] != w {
+			t.Errorf("changes[%d] = %+v, want %+v", i, c.Changes[i], w)
+		}
+	}
+}
+
+func TestRenamedPath(t *testing.T) {
 	tests := []struct {
 		input    string
-		expected int
+		expected string
 	}{
-		{"3 files changed, 100 insertions(+), 20 deletions(-)", 3},
-		{"1 file changed, 10 insertions(+)", 1},
-		{"", 0},
+		{"main.go", "main.go"},
+		{"old.go => new.go", "new.go"},
+		{"cmd/{a.go => b.go}", "cmd/b.go"},
+		{"src/{ => sub}/x.go", "src/sub/x.go"},
+		{"{old => }/x.go", "x.go"},
 	}
 
 	for _, tt := range tests {
-		if got := parseFileCount(tt.input); got != tt.expected {
-			t.Errorf("parseFileCount(%q) = %d, want %d", tt.input, got, tt.expected)
+		if got := renamedPath(tt.input); got != tt.expected {
+			t.Errorf("renamedPath(%q) = %q, want %q", tt.input, got, tt.expected)
 		}
 	}
 }
@@ -0,0 +1,103 @@
+package git
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// 병합 커밋 처리 방식 (merges 설정)
+const (
+	MergesShow     = "show"     // 일반 커밋처럼 보여주고 집계한다
+	MergesHide     = "hide"     // 수집하지 않는다 (git log --no-merges)
+	MergesCollapse = "collapse" // 커밋 목록과 집계에서 빼고 레포마다 "병합 PR #12" 한 줄로 보여준다 (기본)
+)
+
+// mergePatterns는 병합 커밋 메시지에서 PR 번호나 브랜치 이름을 찾는 패턴이다.
+// 첫 번째 그룹이 PR 번호면 "PR #12", 아니면 그룹 값을 그대로 쓴다.
+var mergePatterns = []struct {
+	re *regexp.Regexp
+	pr bool
+}{
+	{regexp.MustCompile(`^Merge pull request #(\d+)`), true},                    // GitHub
+	{regexp.MustCompile(`\(pull request #(\d+)\)`), true},                       // Bitbucket: Merged in x (pull request #7)
+	{regexp.MustCompile(`^Merged PR (\d+)`), true},                              // Azure DevOps
+	{regexp.MustCompile(`^Merge (?:remote-tracking )?branch '([^']+)'`), false}, // git merge, GitLab
+}
+
+// CheckMerges는 병합 커밋 처리 방식 값이 올바른지 확인한다.
+func CheckMerges(mode string) error {
+	switch mode {
+	case "", MergesShow, MergesHide, MergesCollapse:
+		return nil
+	}
+	return i18n.Errorf("err.merges", mode)
+}
+
+// MergeLabel은 병합 커밋을 한 줄로 보여줄 때의 이름이다.
+// "Merge pull request #12 from ..."은 "PR #12", "Merge branch 'feature/login'"은 "feature/login"이고,
+// 알 수 없는 형식은 메시지 그대로이다.
+func (c Commit) MergeLabel() string {
+	for _, p := range mergePatterns {
+		if m := p.re.FindStringSubmatch(c.Message); m != nil {
+			if p.pr {
+				return "PR #" + m[1]
+			}
+			return m[1]
+		}
+	}
+	return c.Message
+}
+
+// ApplyMerges는 병합 커밋 처리 방식(mode)에 따라 results를 고친다.
+// collapse는 병합 커밋을 Commits에서 Merges로 옮기고, hide는 버린다. show는 그대로 둔다.
+// 병합 커밋만 있던 레포는 hide면 결과에서 빠지고, collapse면 Merges만 남는다.
+func ApplyMerges(results []RepoResult, mode string) []RepoResult {
+	if mode == MergesShow {
+		return results
+	}
+
+	kept := results[:0]
+	for _, r := range results {
+		commits := make([]Commit, 0, len(r.Commits))
+		var merges []Commit
+		for _, c := range r.Commits {
+			if c.Merge {
+				merges = append(merges, c)
+			} else {
+				commits = append(commits, c)
+			}
+		}
+		r.Commits = commits
+		if mode != MergesHide {
+			r.Merges = append(r.Merges, merges...)
+		}
+		if len(r.Commits) > 0 || len(r.Merges) > 0 {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// MergeSummary는 병합 커밋들을 "PR #12, PR #13, main ×2"처럼 한 줄로 만든다.
+// 같은 이름(git pull로 반복된 main 병합 등)은 한 번만 쓰고 횟수를 붙인다.
+func MergeSummary(merges []Commit) string {
+	counts := make(map[string]int)
+	var labels []string
+	for _, c := range merges {
+		label := c.MergeLabel()
+		if counts[label] == 0 {
+			labels = append(labels, label)
+		}
+		counts[label]++
+	}
+
+	for i, label := range labels {
+		if n := counts[label]; n > 1 {
+			labels[i] = fmt.Sprintf("%s ×%d", label, n)
+		}
+	}
+	return strings.Join(labels, ", ")
+}
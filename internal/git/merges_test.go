@@ -0,0 +1,114 @@
+package git
+
+import "testing"
+
+func TestParseGitLog_Merge(t *testing.T) {
+	raw := `abc1234567890§§Merge pull request #12 from kso/login§§wook§§2026-02-26T15:00:00+09:00§§refs/heads/main§§1111111 2222222
+def7890123456§§로그인 화면§§wook§§2026-02-26T14:00:00+09:00§§refs/heads/main§§3333333
+
+ 1 file changed, 10 insertions(+)`
+
+	commits, err := parseGitLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 2 {
+		t.Fatalf("expected 2 commits, got %d", len(commits))
+	}
+	if !commits[0].Merge {
+		t.Error("commit with two parents should be a merge")
+	}
+	if commits[1].Merge {
+		t.Error("commit with one parent should not be a merge")
+	}
+}
+
+func TestMergeLabel(t *testing.T) {
+	tests := []struct {
+		msg, want string
+	}{
+		{"Merge pull request #12 from kso/login", "PR #12"},
+		{"Merged in feature/pay (pull request #7)", "PR #7"},
+		{"Merged PR 42: 결제 취소", "PR #42"},
+		{"Merge branch 'main' of github.com:kso/gitday", "main"},
+		{"Merge remote-tracking branch 'origin/main'", "origin/main"},
+		{"Merge branch 'feature/login' into 'main'", "feature/login"},
+		{"수동 병합", "수동 병합"},
+	}
+	for _, tt := range tests {
+		if got := (Commit{Message: tt.msg}).MergeLabel(); got != tt.want {
+			t.Errorf("MergeLabel(%q) = %q, want %q", tt.msg, got, tt.want)
+		}
+	}
+}
+
+func mergeResults() []RepoResult {
+	return []RepoResult{
+		{Name: "api", Commits: []Commit{
+			{Hash: "a1", Message: "Merge pull request #12 from kso/login", Merge: true},
+			{Hash: "a2", Message: "로그인 화면"},
+		}},
+		{Name: "web", Commits: []Commit{
+			{Hash: "w1", Message: "Merge branch 'main'", Merge: true},
+		}},
+	}
+}
+
+func TestApplyMerges(t *testing.T) {
+	t.Run("collapse", func(t *testing.T) {
+		results := ApplyMerges(mergeResults(), MergesCollapse)
+		if len(results) != 2 {
+			t.Fatalf("repos = %d, want 2", len(results))
+		}
+		if len(results[0].Commits) != 1 || results[0].Commits[0].Hash != "a2" {
+			t.Errorf("api commits = %+v", results[0].Commits)
+		}
+		if len(results[0].Merges) != 1 || results[0].Merges[0].Hash != "a1" {
+			t.Errorf("api merges = %+v", results[0].Merges)
+		}
+		// 병합 커밋만 있던 레포는 Merges만 남는다
+		if len(results[1].Commits) != 0 || len(results[1].Merges) != 1 {
+			t.Errorf("web = %+v", results[1])
+		}
+	})
+
+	t.Run("hide", func(t *testing.T) {
+		results := ApplyMerges(mergeResults(), MergesHide)
+		if len(results) != 1 || results[0].Name != "api" {
+			t.Fatalf("results = %+v", results)
+		}
+		if len(results[0].Commits) != 1 || len(results[0].Merges) != 0 {
+			t.Errorf("api = %+v", results[0])
+		}
+	})
+
+	t.Run("show", func(t *testing.T) {
+		results := ApplyMerges(mergeResults(), MergesShow)
+		if len(results) != 2 || len(results[0].Commits) != 2 || len(results[0].Merges) != 0 {
+			t.Errorf("results = %+v", results)
+		}
+	})
+}
+
+func TestMergeSummary(t *testing.T) {
+	merges := []Commit{
+		{Message: "Merge pull request #12 from kso/login"},
+		{Message: "Merge branch 'main' of github.com:kso/gitday"},
+		{Message: "Merge pull request #13 from kso/pay"},
+		{Message: "Merge branch 'main' of github.com:kso/gitday"},
+	}
+	if got, want := MergeSummary(merges), "PR #12, main ×2, PR #13"; got != want {
+		t.Errorf("MergeSummary = %q, want %q", got, want)
+	}
+}
+
+func TestCheckMerges(t *testing.T) {
+	for _, mode := range []string{"", MergesShow, MergesHide, MergesCollapse} {
+		if err := CheckMerges(mode); err != nil {
+			t.Errorf("CheckMerges(%q) = %v", mode, err)
+		}
+	}
+	if err := CheckMerges("squash"); err == nil {
+		t.Error("CheckMerges(squash) should fail")
+	}
+}
@@ -0,0 +1,183 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RepoKind는 탐색된 레포의 형태이다.
+type RepoKind string
+
+const (
+	KindNormal    RepoKind = "normal"    // 일반 레포 (.git 디렉토리)
+	KindWorktree  RepoKind = "worktree"  // git worktree로 만든 연결 작업 트리 (.git 파일)
+	KindSubmodule RepoKind = "submodule" // 서브모듈 (.git 파일 → .git/modules/...)
+	KindBare      RepoKind = "bare"      // 작업 트리 없는 bare 레포
+)
+
+// Repo는 탐색된 git 레포 정보이다.
+type Repo struct {
+	Path      string   // 작업 디렉토리 (bare는 레포 디렉토리 자체)
+	Kind      RepoKind // 레포 형태
+	GitDir    string   // 이 작업 트리의 git 디렉토리
+	CommonDir string   // 오브젝트 DB를 공유하는 공통 git 디렉토리
+	Branch    string   // 현재 체크아웃된 브랜치 (detached면 짧은 해시)
+
+	// Worktrees는 같은 CommonDir를 공유해 하나로 합쳐진 작업 트리들이다.
+	// 연결 워크트리가 하나라도 있을 때만 채워지며 대표 레포 자신도 포함한다.
+	Worktrees []Worktree
+}
+
+// Worktree는 같은 오브젝트 DB를 공유하는 작업 트리 하나이다.
+type Worktree struct {
+	Path   string
+	Branch string
+}
+
+// Name은 리포트에 표시할 레포 이름이다. bare 레포는 ".git" 접미사를 뗀다.
+func (r Repo) Name() string {
+	name := filepath.Base(r.Path)
+	if r.Kind == KindBare {
+		name = strings.TrimSuffix(name, ".git")
+	}
+	return name
+}
+
+// detectRepo는 path가 git 레포(일반/워크트리/서브모듈/bare)인지 판별한다.
+func detectRepo(path string) (Repo, bool) {
+	dotGit := filepath.Join(path, ".git")
+	info, err := os.Stat(dotGit)
+
+	switch {
+	case err == nil && info.IsDir():
+		return newRepo(path, KindNormal, dotGit), true
+
+	case err == nil && info.Mode().IsRegular():
+		// 워크트리/서브모듈: .git 파일에 "gitdir: <경로>"
+		gitDir, ok := readGitDirFile(dotGit)
+		if !ok {
+			return Repo{}, false
+		}
+		kind := KindSubmodule
+		if fileExists(filepath.Join(gitDir, "commondir")) {
+			kind = KindWorktree
+		}
+		return newRepo(path, kind, gitDir), true
+
+	case isBareRepo(path):
+		return newRepo(path, KindBare, path), true
+	}
+
+	return Repo{}, false
+}
+
+func newRepo(path string, kind RepoKind, gitDir string) Repo {
+	return Repo{
+		Path:      path,
+		Kind:      kind,
+		GitDir:    gitDir,
+		CommonDir: resolveCommonDir(gitDir),
+		Branch:    readBranch(gitDir),
+	}
+}
+
+// readGitDirFile은 .git 파일의 "gitdir:" 경로를 절대 경로로 읽는다.
+func readGitDirFile(dotGit string) (string, bool) {
+	data, err := os.ReadFile(dotGit)
+	if err != nil {
+		return "", false
+	}
+	line := strings.TrimSpace(string(data))
+	gitDir, ok := strings.CutPrefix(line, "gitdir:")
+	if !ok {
+		return "", false
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(filepath.Dir(dotGit), gitDir)
+	}
+	if !isDir(gitDir) {
+		return "", false
+	}
+	return filepath.Clean(gitDir), true
+}
+
+// resolveCommonDir는 git 디렉토리의 commondir 파일을 따라 공통 git 디렉토리를 구한다.
+// 심볼릭 링크를 풀어 같은 오브젝트 DB면 항상 같은 경로가 나오도록 한다.
+func resolveCommonDir(gitDir string) string {
+	common := gitDir
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		dir := strings.TrimSpace(string(data))
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(gitDir, dir)
+		}
+		common = dir
+	}
+	if real, err := filepath.EvalSymlinks(common); err == nil {
+		return real
+	}
+	return filepath.Clean(common)
+}
+
+// readBranch는 HEAD를 읽어 현재 브랜치 이름을 돌려준다.
+func readBranch(gitDir string) string {
+	data, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return ""
+	}
+	head := strings.TrimSpace(string(data))
+	if ref, ok := strings.CutPrefix(head, "ref: "); ok {
+		return strings.TrimPrefix(ref, "refs/heads/")
+	}
+	return truncate(head, 7)
+}
+
+// isBareRepo는 디렉토리 자체가 bare 레포(HEAD, objects/, refs/)인지 확인한다.
+func isBareRepo(path string) bool {
+	return fileExists(filepath.Join(path, "HEAD")) &&
+		isDir(filepath.Join(path, "objects")) &&
+		isDir(filepath.Join(path, "refs"))
+}
+
+// dedupeWorktrees는 같은 오브젝트 DB를 공유하는 레포들을 하나로 합친다.
+// 대표는 메인 작업 트리(연결 워크트리가 아닌 쪽)이며, 없으면 먼저 찾은 워크트리이다.
+func dedupeWorktrees(repos []Repo) []Repo {
+	index := make(map[string]int)
+	var merged []Repo
+
+	for _, r := range repos {
+		i, ok := index[r.CommonDir]
+		if !ok {
+			index[r.CommonDir] = len(merged)
+			if r.Kind == KindWorktree {
+				r.Worktrees = []Worktree{{Path: r.Path, Branch: r.Branch}}
+			}
+			merged = append(merged, r)
+			continue
+		}
+
+		rep := &merged[i]
+		if len(rep.Worktrees) == 0 {
+			rep.Worktrees = []Worktree{{Path: rep.Path, Branch: rep.Branch}}
+		}
+		rep.Worktrees = append(rep.Worktrees, Worktree{Path: r.Path, Branch: r.Branch})
+		if rep.Kind == KindWorktree && r.Kind != KindWorktree {
+			worktrees := rep.Worktrees
+			*rep = r
+			rep.Worktrees = worktrees
+		}
+	}
+
+	return merged
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
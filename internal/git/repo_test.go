@@ -0,0 +1,127 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeFile은 테스트용 파일을 상위 디렉토리와 함께 만든다.
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// makeWorktreeFixture는 main 레포와 그 연결 워크트리 wt를 흉내 낸 디렉토리를 만든다.
+func makeWorktreeFixture(t *testing.T, root string) {
+	t.Helper()
+	mainGit := filepath.Join(root, "main", ".git")
+	writeFile(t, filepath.Join(mainGit, "HEAD"), "ref: refs/heads/main\n")
+	os.MkdirAll(filepath.Join(mainGit, "objects"), 0755)
+	os.MkdirAll(filepath.Join(mainGit, "refs"), 0755)
+
+	wtGit := filepath.Join(mainGit, "worktrees", "wt")
+	writeFile(t, filepath.Join(wtGit, "HEAD"), "ref: refs/heads/feature/login\n")
+	writeFile(t, filepath.Join(wtGit, "commondir"), "../..\n")
+	writeFile(t, filepath.Join(root, "wt", ".git"), "gitdir: ../main/.git/worktrees/wt\n")
+}
+
+func TestDetectRepo_Kinds(t *testing.T) {
+	tmp := t.TempDir()
+	makeWorktreeFixture(t, tmp)
+
+	// 서브모듈: .git 파일이 상위 레포의 .git/modules를 가리킨다 (commondir 없음)
+	modGit := filepath.Join(tmp, "main", ".git", "modules", "lib")
+	writeFile(t, filepath.Join(modGit, "HEAD"), "0123456789abcdef\n")
+	writeFile(t, filepath.Join(tmp, "main", "lib", ".git"), "gitdir: ../.git/modules/lib\n")
+
+	// bare 레포
+	bare := filepath.Join(tmp, "server.git")
+	writeFile(t, filepath.Join(bare, "HEAD"), "ref: refs/heads/main\n")
+	os.MkdirAll(filepath.Join(bare, "objects"), 0755)
+	os.MkdirAll(filepath.Join(bare, "refs"), 0755)
+
+	tests := []struct {
+		path   string
+		kind   RepoKind
+		branch string
+		name   string
+	}{
+		{filepath.Join(tmp, "main"), KindNormal, "main", "main"},
+		{filepath.Join(tmp, "wt"), KindWorktree, "feature/login", "wt"},
+		{filepath.Join(tmp, "main", "lib"), KindSubmodule, "0123456", "lib"},
+		{bare, KindBare, "main", "server"},
+	}
+
+	for _, tt := range tests {
+		r, ok := detectRepo(tt.path)
+		if !ok {
+			t.Errorf("%s: not detected", tt.path)
+			continue
+		}
+		if r.Kind != tt.kind || r.Branch != tt.branch || r.Name() != tt.name {
+			t.Errorf("%s: got (%s, %q, %q), want (%s, %q, %q)",
+				tt.path, r.Kind, r.Branch, r.Name(), tt.kind, tt.branch, tt.name)
+		}
+	}
+
+	// 워크트리와 메인 레포는 같은 CommonDir를 가진다
+	mainRepo, _ := detectRepo(filepath.Join(tmp, "main"))
+	wtRepo, _ := detectRepo(filepath.Join(tmp, "wt"))
+	if mainRepo.CommonDir != wtRepo.CommonDir {
+		t.Errorf("common dir mismatch: %q vs %q", mainRepo.CommonDir, wtRepo.CommonDir)
+	}
+
+	// gitdir이 가리키는 곳이 없으면 레포가 아니다
+	writeFile(t, filepath.Join(tmp, "broken", ".git"), "gitdir: ../nowhere\n")
+	if _, ok := detectRepo(filepath.Join(tmp, "broken")); ok {
+		t.Error("broken .git file should not be detected")
+	}
+}
+
+func TestScanRepos_DedupeWorktrees(t *testing.T) {
+	tmp := t.TempDir()
+	makeWorktreeFixture(t, tmp)
+
+	repos, err := ScanRepos([]string{tmp}, nil, ScanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("expected 1 repo after dedupe, got %d: %v", len(repos), repos)
+	}
+
+	r := repos[0]
+	if r.Kind != KindNormal || filepath.Base(r.Path) != "main" {
+		t.Errorf("representative = %s (%s), want main (normal)", r.Path, r.Kind)
+	}
+	if len(r.Worktrees) != 2 {
+		t.Fatalf("expected 2 worktrees, got %v", r.Worktrees)
+	}
+	if r.Worktrees[0].Branch != "main" || r.Worktrees[1].Branch != "feature/login" {
+		t.Errorf("worktree branches = %v", r.Worktrees)
+	}
+}
+
+func TestRepoResultLabel(t *testing.T) {
+	tests := []struct {
+		result RepoResult
+		want   string
+	}{
+		{RepoResult{Kind: KindNormal}, ""},
+		{RepoResult{Kind: KindWorktree, Branch: "feature/x"}, "worktree: feature/x"},
+		{RepoResult{Kind: KindBare}, "bare"},
+		{RepoResult{Kind: KindNormal, Worktrees: []Worktree{{Branch: "main"}, {Branch: "hotfix"}}}, "worktrees: main, hotfix"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.result.Label(); got != tt.want {
+			t.Errorf("Label() = %q, want %q", got, tt.want)
+		}
+	}
+}
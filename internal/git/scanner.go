@@ -6,56 +6,93 @@ import (
 	"strings"
 )
 
-// ScanRepos는 주어진 경로들에서 .git 디렉토리를 찾아 레포 경로 목록을 반환한다.
-// 1단계 깊이만 탐색 (scan_path/project/.git)
-func ScanRepos(scanPaths []string, excludes []string) ([]string, error) {
-	var repos []string
-	seen := make(map[string]bool)
+// DefaultScanDepth는 ScanOptions.Depth가 지정되지 않았을 때의 탐색 깊이이다.
+// 1이면 scan_path/project/.git 까지만 본다.
+const DefaultScanDepth = 1
 
-	for _, sp := range scanPaths {
-		expanded := expandHome(sp)
-
-		// 해당 경로 자체가 git 레포인 경우
-		if isGitRepo(expanded) {
-			abs, _ := filepath.Abs(expanded)
-			if !seen[abs] {
-				repos = append(repos, abs)
-				seen[abs] = true
-			}
-			continue
-		}
+// ScanOptions는 레포 탐색 옵션이다.
+type ScanOptions struct {
+	Depth  int  // scan_path 아래로 내려갈 최대 단계 (0이면 DefaultScanDepth)
+	Nested bool // 레포를 찾은 뒤에도 그 안쪽으로 계속 탐색 (중첩 레포)
+}
+
+// ScanRepos는 주어진 경로들에서 git 레포를 찾아 목록을 반환한다.
+// scan_path 자체부터 opts.Depth 단계 아래까지 탐색하며, 모든 단계에서 excludes를 적용한다.
+// 심볼릭 링크 디렉토리도 따라가지만 실제 경로 기준으로 한 번만 방문하므로 순환하지 않는다.
+// 일반 레포 외에 워크트리, 서브모듈, bare 레포도 인식하며, 같은 오브젝트 DB를
+// 공유하는 워크트리들은 커밋이 중복 집계되지 않도록 하나의 Repo로 합친다.
+func ScanRepos(scanPaths []string, excludes []string, opts ScanOptions) ([]Repo, error) {
+	depth := opts.Depth
+	if depth <= 0 {
+		depth = DefaultScanDepth
+	}
 
-		// 하위 디렉토리 탐색
-		entries, err := os.ReadDir(expanded)
+	s := &scanner{
+		excludes: excludes,
+		nested:   opts.Nested,
+		visited:  make(map[string]bool),
+	}
+
+	for _, sp := range scanPaths {
+		abs, err := filepath.Abs(expandHome(sp))
 		if err != nil {
 			continue
 		}
+		s.walk(abs, depth)
+	}
 
-		for _, entry := range entries {
-			if !entry.IsDir() {
-				continue
-			}
-			name := entry.Name()
+	return dedupeWorktrees(s.repos), nil
+}
 
-			if shouldExclude(name, excludes) {
-				continue
-			}
+type scanner struct {
+	excludes []string
+	nested   bool
+	visited  map[string]bool // 실제 경로(EvalSymlinks) 기준 방문 기록
+	repos    []Repo
+}
 
-			fullPath := filepath.Join(expanded, name)
-			abs, _ := filepath.Abs(fullPath)
+func (s *scanner) walk(dir string, remaining int) {
+	real, err := filepath.EvalSymlinks(dir)
+	if err != nil || s.visited[real] {
+		return
+	}
+	s.visited[real] = true
 
-			if isGitRepo(abs) && !seen[abs] {
-				repos = append(repos, abs)
-				seen[abs] = true
-			}
+	if repo, ok := detectRepo(dir); ok {
+		s.repos = append(s.repos, repo)
+		if !s.nested {
+			return
 		}
 	}
 
-	return repos, nil
+	if remaining == 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if shouldExclude(name, s.excludes) {
+			continue
+		}
+
+		fullPath := filepath.Join(dir, name)
+		if !entry.IsDir() && !isSymlinkToDir(entry, fullPath) {
+			continue
+		}
+		s.walk(fullPath, remaining-1)
+	}
 }
 
-func isGitRepo(path string) bool {
-	info, err := os.Stat(filepath.Join(path, ".git"))
+func isSymlinkToDir(entry os.DirEntry, path string) bool {
+	if entry.Type()&os.ModeSymlink == 0 {
+		return false
+	}
+	info, err := os.Stat(path)
 	return err == nil && info.IsDir()
 }
 
@@ -3,6 +3,8 @@ package git
 import (
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -29,7 +31,7 @@ func TestScanRepos(t *testing.T) {
 	// 일반 디렉토리 (git 아님)
 	os.MkdirAll(filepath.Join(tmp, "not-a-repo"), 0755)
 
-	repos, err := ScanRepos([]string{tmp}, []string{"node_modules"})
+	repos, err := ScanRepos([]string{tmp}, []string{"node_modules"}, ScanOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -41,7 +43,7 @@ func TestScanRepos(t *testing.T) {
 	// 이름 확인
 	names := make(map[string]bool)
 	for _, r := range repos {
-		names[filepath.Base(r)] = true
+		names[filepath.Base(r.Path)] = true
 	}
 	if !names["project-a"] || !names["project-b"] {
 		t.Errorf("expected project-a and project-b, got %v", names)
@@ -52,7 +54,7 @@ func TestScanRepos_SelfIsGitRepo(t *testing.T) {
 	tmp := t.TempDir()
 	os.MkdirAll(filepath.Join(tmp, ".git"), 0755)
 
-	repos, err := ScanRepos([]string{tmp}, nil)
+	repos, err := ScanRepos([]string{tmp}, nil, ScanOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -62,6 +64,89 @@ func TestScanRepos_SelfIsGitRepo(t *testing.T) {
 	}
 }
 
+func TestScanRepos_Depth(t *testing.T) {
+	tmp := t.TempDir()
+
+	mkRepo := func(parts ...string) {
+		gitDir := filepath.Join(append([]string{tmp}, append(parts, ".git")...)...)
+		if err := os.MkdirAll(gitDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mkRepo("top")
+	mkRepo("clientA", "service-x")
+	mkRepo("clientA", "node_modules", "dep")
+	mkRepo("clientB", "group", "deep")
+
+	tests := []struct {
+		depth int
+		want  []string
+	}{
+		{1, []string{"top"}},
+		{2, []string{"service-x", "top"}},
+		{3, []string{"deep", "service-x", "top"}},
+	}
+
+	for _, tt := range tests {
+		repos, err := ScanRepos([]string{tmp}, []string{"node_modules"}, ScanOptions{Depth: tt.depth})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := baseNames(repos); !equalSorted(got, tt.want) {
+			t.Errorf("depth %d: got %v, want %v", tt.depth, got, tt.want)
+		}
+	}
+}
+
+func TestScanRepos_Nested(t *testing.T) {
+	tmp := t.TempDir()
+	os.MkdirAll(filepath.Join(tmp, "outer", ".git"), 0755)
+	os.MkdirAll(filepath.Join(tmp, "outer", "inner", ".git"), 0755)
+
+	repos, _ := ScanRepos([]string{tmp}, nil, ScanOptions{Depth: 3})
+	if got := baseNames(repos); !equalSorted(got, []string{"outer"}) {
+		t.Errorf("without nested: got %v, want [outer]", got)
+	}
+
+	repos, _ = ScanRepos([]string{tmp}, nil, ScanOptions{Depth: 3, Nested: true})
+	if got := baseNames(repos); !equalSorted(got, []string{"inner", "outer"}) {
+		t.Errorf("with nested: got %v, want [inner outer]", got)
+	}
+}
+
+func TestScanRepos_SymlinkLoop(t *testing.T) {
+	tmp := t.TempDir()
+	os.MkdirAll(filepath.Join(tmp, "a", "repo", ".git"), 0755)
+	// a/loop -> tmp (자기 자신을 가리키는 순환)
+	if err := os.Symlink(tmp, filepath.Join(tmp, "a", "loop")); err != nil {
+		t.Skip("symlink not supported:", err)
+	}
+	// linked -> a/repo (같은 레포를 가리키는 링크는 한 번만 센다)
+	os.Symlink(filepath.Join(tmp, "a", "repo"), filepath.Join(tmp, "linked"))
+
+	repos, err := ScanRepos([]string{tmp}, nil, ScanOptions{Depth: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Errorf("expected 1 repo, got %d: %v", len(repos), repos)
+	}
+}
+
+func baseNames(repos []Repo) []string {
+	names := make([]string, len(repos))
+	for i, r := range repos {
+		names[i] = filepath.Base(r.Path)
+	}
+	return names
+}
+
+func equalSorted(got, want []string) bool {
+	sort.Strings(got)
+	sort.Strings(want)
+	return strings.Join(got, ",") == strings.Join(want, ",")
+}
+
 func TestExpandHome(t *testing.T) {
 	home, _ := os.UserHomeDir()
 	result := expandHome("~/test")
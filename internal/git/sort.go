@@ -0,0 +1,80 @@
+package git
+
+import (
+	"sort"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// 레포 정렬 기준
+const (
+	SortByName    = "name"    // 이름 오름차순 (기본)
+	SortByCommits = "commits" // 커밋 수 많은 순
+	SortByChurn   = "churn"   // 변경 라인(+/-) 많은 순
+	SortByRecent  = "recent"  // 최근 커밋이 있는 순
+)
+
+// 커밋 정렬 순서
+const (
+	OrderDesc = "desc" // 최신 커밋 먼저 (기본, git log와 동일)
+	OrderAsc  = "asc"  // 오래된 커밋 먼저
+)
+
+// SortResults는 레포 결과를 주어진 기준으로 정렬한다.
+// 기준 값이 같으면 이름, 경로 순으로 정렬해 실행할 때마다 같은 순서를 보장한다.
+func SortResults(results []RepoResult, by string) error {
+	var cmp func(a, b RepoResult) int
+
+	switch by {
+	case "", SortByName:
+		cmp = func(a, b RepoResult) int { return 0 }
+	case SortByCommits:
+		cmp = func(a, b RepoResult) int { return len(b.Commits) - len(a.Commits) }
+	case SortByChurn:
+		cmp = func(a, b RepoResult) int { return churn(b) - churn(a) }
+	case SortByRecent:
+		cmp = func(a, b RepoResult) int { return b.LastCommit().Compare(a.LastCommit()) }
+	default:
+		return i18n.Errorf("err.sort", by)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if c := cmp(a, b); c != 0 {
+			return c < 0
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Path < b.Path
+	})
+	return nil
+}
+
+// SortCommits는 각 레포의 커밋을 시간순으로 정렬한다. 같은 시각이면 해시 순이다.
+func SortCommits(results []RepoResult, order string) error {
+	var asc bool
+	switch order {
+	case "", OrderDesc:
+		asc = false
+	case OrderAsc:
+		asc = true
+	default:
+		return i18n.Errorf("err.commit_order", order)
+	}
+
+	for _, r := range results {
+		sort.SliceStable(r.Commits, func(i, j int) bool {
+			a, b := r.Commits[i], r.Commits[j]
+			if !a.Date.Equal(b.Date) {
+				return a.Date.After(b.Date) != asc
+			}
+			return a.Hash < b.Hash
+		})
+	}
+	return nil
+}
+
+func churn(r RepoResult) int {
+	return r.TotalInsertions() + r.TotalDeletions()
+}
@@ -0,0 +1,83 @@
+package git
+
+import (
+	"testing"
+	"time"
+)
+
+func sortFixture() []RepoResult {
+	base := time.Date(2026, 2, 26, 9, 0, 0, 0, time.UTC)
+	return []RepoResult{
+		{Name: "petition", Path: "/b/petition", Commits: []Commit{
+			{Hash: "p1", Date: base.Add(5 * time.Hour), Insertions: 10},
+		}},
+		{Name: "rpg", Path: "/a/rpg", Commits: []Commit{
+			{Hash: "r1", Date: base, Insertions: 300, Deletions: 50},
+			{Hash: "r2", Date: base.Add(2 * time.Hour), Insertions: 5},
+		}},
+		{Name: "api", Path: "/c/api", Commits: []Commit{
+			{Hash: "a1", Date: base.Add(time.Hour), Insertions: 20},
+		}},
+	}
+}
+
+func names(results []RepoResult) []string {
+	out := make([]string, len(results))
+	for i, r := range results {
+		out[i] = r.Name
+	}
+	return out
+}
+
+func TestSortResults(t *testing.T) {
+	tests := []struct {
+		by   string
+		want []string
+	}{
+		{"", []string{"api", "petition", "rpg"}},
+		{SortByName, []string{"api", "petition", "rpg"}},
+		{SortByCommits, []string{"rpg", "api", "petition"}},
+		{SortByChurn, []string{"rpg", "api", "petition"}},
+		{SortByRecent, []string{"petition", "rpg", "api"}},
+	}
+
+	for _, tt := range tests {
+		results := sortFixture()
+		if err := SortResults(results, tt.by); err != nil {
+			t.Fatal(err)
+		}
+		got := names(results)
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("SortResults(%q) = %v, want %v", tt.by, got, tt.want)
+				break
+			}
+		}
+	}
+
+	if err := SortResults(sortFixture(), "size"); err == nil {
+		t.Error("expected error for unknown sort key")
+	}
+}
+
+func TestSortCommits(t *testing.T) {
+	results := sortFixture()
+
+	if err := SortCommits(results, OrderAsc); err != nil {
+		t.Fatal(err)
+	}
+	if results[1].Commits[0].Hash != "r1" {
+		t.Errorf("asc: first commit = %s, want r1", results[1].Commits[0].Hash)
+	}
+
+	if err := SortCommits(results, OrderDesc); err != nil {
+		t.Fatal(err)
+	}
+	if results[1].Commits[0].Hash != "r2" {
+		t.Errorf("desc: first commit = %s, want r2", results[1].Commits[0].Hash)
+	}
+
+	if err := SortCommits(results, "random"); err == nil {
+		t.Error("expected error for unknown order")
+	}
+}
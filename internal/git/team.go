@@ -0,0 +1,158 @@
+package git
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// Member는 팀 설정(team)의 한 사람이다.
+// Aliases는 이름이나 이메일로, 작성자 필터(LogOptions.Authors)와 달리 일부가 아니라 전체가 같아야 한다.
+type Member struct {
+	Name    string
+	Aliases []string
+}
+
+// Team은 커밋 작성자와 공동 작성자를 팀원에게 연결한다.
+type Team struct {
+	members []Member
+}
+
+// NewTeam은 팀원 목록으로 Team을 만든다. 목록이 비어 있으면 .mailmap을 적용한 작성자 이름을 한 사람으로 본다.
+func NewTeam(members []Member) (*Team, error) {
+	seen := make(map[string]bool)
+	for _, m := range members {
+		if strings.TrimSpace(m.Name) == "" {
+			return nil, i18n.Errorf("err.team_name")
+		}
+		if seen[m.Name] {
+			return nil, i18n.Errorf("err.team_duplicate", m.Name)
+		}
+		seen[m.Name] = true
+	}
+	return &Team{members: members}, nil
+}
+
+// Person은 한 사람이 작성하거나 공동 작성한 커밋을 레포별로 모은 결과이다.
+type Person struct {
+	Name    string
+	Results []RepoResult
+}
+
+// Commits는 그 사람의 커밋 수이다.
+func (p Person) Commits() int {
+	n := 0
+	for _, r := range p.Results {
+		n += len(r.Commits)
+	}
+	return n
+}
+
+// ByPerson은 results의 커밋을 사람별로 나눈다.
+// 공동 작성한 커밋은 작성자와 공동 작성자 모두에게 들어가고, 병합 커밋(Merges)은 작성자에게만 들어간다.
+// 팀원이 설정되어 있으면 팀원이 아닌 사람은 others로 따로 돌려준다.
+// 둘 다 커밋이 많은 사람부터, 같으면 이름순이다.
+func (t *Team) ByPerson(results []RepoResult) (members, others []Person) {
+	byName := make(map[string]*Person)
+	var order []string
+	repoOf := func(name string, r RepoResult) *RepoResult {
+		p, ok := byName[name]
+		if !ok {
+			p = &Person{Name: name}
+			byName[name] = p
+			order = append(order, name)
+		}
+		if n := len(p.Results); n > 0 && p.Results[n-1].Path == r.Path && p.Results[n-1].Name == r.Name {
+			return &p.Results[n-1]
+		}
+		p.Results = append(p.Results, RepoResult{
+			Name: r.Name, Path: r.Path, Kind: r.Kind, Branch: r.Branch, Worktrees: r.Worktrees,
+		})
+		return &p.Results[len(p.Results)-1]
+	}
+
+	for _, r := range results {
+		for _, c := range r.Commits {
+			for _, name := range t.people(c) {
+				repo := repoOf(name, r)
+				repo.Commits = append(repo.Commits, c)
+			}
+		}
+		for _, c := range r.Merges {
+			name := t.person(c.authorIdentities(), c.Author)
+			repo := repoOf(name, r)
+			repo.Merges = append(repo.Merges, c)
+		}
+	}
+
+	isMember := make(map[string]bool, len(t.members))
+	for _, m := range t.members {
+		isMember[m.Name] = true
+	}
+	for _, name := range order {
+		p := *byName[name]
+		if len(t.members) == 0 || isMember[name] {
+			members = append(members, p)
+		} else {
+			others = append(others, p)
+		}
+	}
+	sortPeople(members)
+	sortPeople(others)
+	return members, others
+}
+
+// people은 커밋의 작성자와 공동 작성자를 사람 이름으로 바꾼다. 같은 사람은 한 번만 들어간다.
+func (t *Team) people(c Commit) []string {
+	names := []string{t.person(c.authorIdentities(), c.Author)}
+	for _, co := range c.CoAuthors {
+		name, _, _ := strings.Cut(co, "<")
+		name = t.person([]string{co}, strings.TrimSpace(name))
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// person은 identities와 일치하는 팀원의 이름을, 없으면 fallback을 돌려준다.
+// 팀원 이름 자체도 별칭으로 본다.
+func (t *Team) person(identities []string, fallback string) string {
+	for _, m := range t.members {
+		if matchAlias(identities, append([]string{m.Name}, m.Aliases...)) {
+			return m.Name
+		}
+	}
+	return fallback
+}
+
+// matchAlias는 identities("이름 <이메일>") 중 하나의 이름이나 이메일이 aliases 중 하나와
+// (대소문자 무시하고) 정확히 같은지 확인한다. "Lee"가 "Leeroy"나 kimlee@를 가져가지 않게 한다.
+func matchAlias(identities, aliases []string) bool {
+	for _, a := range aliases {
+		a = strings.ToLower(strings.Trim(strings.TrimSpace(a), "<>"))
+		if a == "" {
+			continue
+		}
+		for _, id := range identities {
+			name, email, _ := strings.Cut(id, "<")
+			email = strings.TrimSuffix(strings.TrimSpace(email), ">")
+			if a == strings.ToLower(strings.TrimSpace(name)) || a == strings.ToLower(email) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortPeople(people []Person) {
+	sort.SliceStable(people, func(i, j int) bool {
+		ci, cj := people[i].Commits(), people[j].Commits()
+		if ci != cj {
+			return ci > cj
+		}
+		return people[i].Name < people[j].Name
+	})
+}
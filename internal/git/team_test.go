@@ -0,0 +1,124 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func teamResults() []RepoResult {
+	return []RepoResult{
+		{Name: "api", Path: "/src/api", Commits: []Commit{
+			{Hash: "a1", Author: "wook", Email: "wook@work.com", Message: "결제 취소"},
+			{Hash: "a2", Author: "Lee", Email: "lee@example.com", Message: "페어 작업",
+				CoAuthors: []string{"wook <wook@gmail.com>"}},
+			{Hash: "a3", Author: "bot", Email: "bot@ci", Message: "버전 올림"},
+		}, Merges: []Commit{
+			{Hash: "a4", Author: "Lee", Email: "lee@example.com", Message: "Merge pull request #3", Merge: true},
+		}},
+		{Name: "web", Path: "/src/web", Commits: []Commit{
+			{Hash: "w1", Author: "wook", Email: "wook@gmail.com", Message: "로그인 화면"},
+		}},
+	}
+}
+
+// personHashes는 사람별 커밋 해시를 "이름 → 레포:해시" 형태로 모은다.
+func personHashes(people []Person) map[string][]string {
+	m := make(map[string][]string)
+	for _, p := range people {
+		for _, r := range p.Results {
+			for _, c := range r.Commits {
+				m[p.Name] = append(m[p.Name], r.Name+":"+c.Hash)
+			}
+		}
+	}
+	return m
+}
+
+func TestTeamByPerson_Members(t *testing.T) {
+	team, err := NewTeam([]Member{
+		{Name: "Wook Kim", Aliases: []string{"wook@work.com", "wook@gmail.com"}},
+		{Name: "Lee Jin", Aliases: []string{"lee@example.com"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members, others := team.ByPerson(teamResults())
+
+	want := map[string][]string{
+		"Wook Kim": {"api:a1", "api:a2", "web:w1"}, // 공동 작성한 a2도 포함
+		"Lee Jin":  {"api:a2"},
+	}
+	if got := personHashes(members); !reflect.DeepEqual(got, want) {
+		t.Errorf("members = %v, want %v", got, want)
+	}
+	if members[0].Name != "Wook Kim" {
+		t.Errorf("first member = %q, want the one with most commits", members[0].Name)
+	}
+	if got := personHashes(others); !reflect.DeepEqual(got, map[string][]string{"bot": {"api:a3"}}) {
+		t.Errorf("others = %v", got)
+	}
+
+	// 병합 커밋은 작성자에게만 들어간다
+	for _, p := range members {
+		merges := 0
+		for _, r := range p.Results {
+			merges += len(r.Merges)
+		}
+		if want := map[string]int{"Lee Jin": 1}[p.Name]; merges != want {
+			t.Errorf("%s merges = %d, want %d", p.Name, merges, want)
+		}
+	}
+}
+
+func TestTeamByPerson_NoMembers(t *testing.T) {
+	team, _ := NewTeam(nil)
+
+	members, others := team.ByPerson(teamResults())
+
+	if len(others) != 0 {
+		t.Errorf("without team config everyone should be a member, others = %v", personHashes(others))
+	}
+	want := map[string][]string{
+		"wook": {"api:a1", "api:a2", "web:w1"},
+		"Lee":  {"api:a2"},
+		"bot":  {"api:a3"},
+	}
+	if got := personHashes(members); !reflect.DeepEqual(got, want) {
+		t.Errorf("members = %v, want %v", got, want)
+	}
+}
+
+func TestNewTeam_Invalid(t *testing.T) {
+	if _, err := NewTeam([]Member{{Name: ""}}); err == nil {
+		t.Error("empty member name should fail")
+	}
+	if _, err := NewTeam([]Member{{Name: "wook"}, {Name: "wook"}}); err == nil {
+		t.Error("duplicate member name should fail")
+	}
+}
+
+func TestTeamPerson_ExactAlias(t *testing.T) {
+	team, err := NewTeam([]Member{
+		{Name: "Lee", Aliases: []string{"", "LEE@example.com"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		identity string
+		want     string
+	}{
+		{"Lee <lee@work.com>", "Lee"},         // 이름이 같으면 팀원
+		{"Lee Jin <lee@example.com>", "Lee"},  // 이메일이 같으면 팀원
+		{"Leeroy <leeroy@work.com>", "other"}, // 이름 일부는 아니다
+		{"Kim <kimlee@example.com>", "other"}, // 이메일 일부도 아니다
+		{"Park <park@example.com>", "other"},  // 빈 별칭은 아무와도 일치하지 않는다
+	}
+	for _, tt := range tests {
+		if got := team.person([]string{tt.identity}, "other"); got != tt.want {
+			t.Errorf("person(%q) = %q, want %q", tt.identity, got, tt.want)
+		}
+	}
+}
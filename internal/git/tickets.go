@@ -0,0 +1,105 @@
+package git
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// DefaultTicketPatterns는 tickets.patterns 설정이 없을 때 쓰는 이슈 키 패턴이다.
+// Jira 형식(PAY-1234, 프로젝트 키에 영문자가 둘 이상)과 GitHub/GitLab 이슈 번호(#87)를 찾는다.
+// GitHub squash 병합이 제목 끝에 붙이는 "(#123)"은 PR 번호라 이슈로 세지 않는다.
+var DefaultTicketPatterns = []string{`\b[A-Z][A-Z0-9]*[A-Z][A-Z0-9]*-[0-9]+\b`, `(?:^|[^(\w])(#[0-9]+)\b`}
+
+// DefaultTicketIgnore는 tickets.ignore 설정이 없을 때 키로 보지 않는 값이다.
+// Jira 키 모양인 인코딩/해시/표준 이름으로, ES-123 같은 실제 프로젝트 키를 가리지 않도록 접두어가 아니라 전체로 비교한다.
+var DefaultTicketIgnore = []string{
+	"UTF-8", "UTF-16", "UTF-32",
+	"SHA-1", "SHA-224", "SHA-256", "SHA-384", "SHA-512", "SHA3-256", "SHA3-512", "MD5",
+	"ISO-8601", "ISO-8859", "ISO-639", "ISO-3166",
+}
+
+// TicketMatcher는 커밋 메시지와 브랜치 이름에서 이슈 트래커 키를 찾는다.
+type TicketMatcher struct {
+	patterns []*regexp.Regexp
+	ignore   map[string]bool
+}
+
+// NewTicketMatcher는 정규식 목록으로 TicketMatcher를 만든다.
+// 패턴에 캡처 그룹이 있으면 첫 번째 그룹을, 없으면 일치한 전체를 키로 쓴다.
+// ignore는 키로 보지 않을 값(SHA-256 등)이고 대소문자를 무시한다.
+func NewTicketMatcher(patterns, ignore []string) (*TicketMatcher, error) {
+	m := &TicketMatcher{ignore: make(map[string]bool, len(ignore))}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, i18n.Errorf("err.ticket_pattern", p, err)
+		}
+		m.patterns = append(m.patterns, re)
+	}
+	for _, key := range ignore {
+		m.ignore[strings.ToUpper(key)] = true
+	}
+	return m, nil
+}
+
+// Find는 texts에서 찾은 키를 처음 나온 순서대로, 중복 없이 반환한다.
+func (m *TicketMatcher) Find(texts ...string) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, re := range m.patterns {
+			for _, match := range re.FindAllStringSubmatch(text, -1) {
+				key := match[0]
+				if len(match) > 1 && match[1] != "" {
+					key = match[1]
+				}
+				if !seen[key] && !m.ignore[strings.ToUpper(key)] {
+					seen[key] = true
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// BranchName은 ref 이름에서 refs/heads/, refs/remotes/ 같은 접두어를 뗀다.
+// "refs/remotes/origin/main"은 "origin/main"이 된다.
+func BranchName(ref string) string {
+	for _, prefix := range []string{"refs/heads/", "refs/remotes/", "refs/tags/", "refs/"} {
+		if name, ok := strings.CutPrefix(ref, prefix); ok {
+			return name
+		}
+	}
+	return ref
+}
+
+// CompareTickets는 티켓 키를 사람이 기대하는 순서로 비교한다.
+// 접두어가 같으면 번호를 숫자로 비교해 PAY-9가 PAY-10보다 앞에 온다.
+func CompareTickets(a, b string) int {
+	pa, na := splitTicket(a)
+	pb, nb := splitTicket(b)
+	if pa != pb {
+		return strings.Compare(pa, pb)
+	}
+	if na != nb {
+		return na - nb
+	}
+	return strings.Compare(a, b)
+}
+
+// splitTicket은 키를 끝의 숫자 앞부분과 숫자로 나눈다. 숫자가 없으면 -1이다.
+func splitTicket(key string) (prefix string, number int) {
+	i := len(key)
+	for i > 0 && key[i-1] >= '0' && key[i-1] <= '9' {
+		i--
+	}
+	n, err := strconv.Atoi(key[i:])
+	if err != nil {
+		return key, -1
+	}
+	return key[:i], n
+}
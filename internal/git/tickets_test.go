@@ -0,0 +1,106 @@
+package git
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTicketMatcher_Find(t *testing.T) {
+	m, err := NewTicketMatcher(DefaultTicketPatterns, DefaultTicketIgnore)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		texts []string
+		want  []string
+	}{
+		{[]string{"PAY-1234 결제 취소 처리", "feature/PAY-1234-cancel"}, []string{"PAY-1234"}},
+		{[]string{"fix: 로그인 오류 #87", "main"}, []string{"#87"}},
+		{[]string{"#87 로그인 오류", "main"}, []string{"#87"}},
+		{[]string{"fix: 로그인 오류 (#123)", "main"}, nil},          // squash 병합의 PR 번호
+		{[]string{"UTF-8, SHA-256, ISO-8601 처리", "main"}, nil}, // 인코딩/해시/표준 이름
+		{[]string{"ES-123 MD-42 HTTP-7 처리", "main"}, []string{"ES-123", "MD-42", "HTTP-7"}},
+		{[]string{"A-1 정리", "main"}, nil}, // 프로젝트 키에 영문자가 둘 이상
+		{[]string{"K8S-12 배포", "main"}, []string{"K8S-12"}},
+		{[]string{"PAY-12, PAY-13 정리", "hotfix/OPS-7"}, []string{"PAY-12", "PAY-13", "OPS-7"}},
+		{[]string{"README 정리", "main"}, nil},
+	}
+	for _, tt := range tests {
+		if got := m.Find(tt.texts...); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Find(%q) = %q, want %q", tt.texts, got, tt.want)
+		}
+	}
+}
+
+func TestTicketMatcher_CaptureGroup(t *testing.T) {
+	m, err := NewTicketMatcher([]string{`(?i)issue[- ]?(\d+)`}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Find("Fix Issue 42 and issue-43"); !reflect.DeepEqual(got, []string{"42", "43"}) {
+		t.Errorf("Find = %q, want [42 43]", got)
+	}
+}
+
+func TestNewTicketMatcher_Invalid(t *testing.T) {
+	if _, err := NewTicketMatcher([]string{"PAY-("}, nil); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
+
+func TestBranchName(t *testing.T) {
+	tests := map[string]string{
+		"refs/heads/feature/login": "feature/login",
+		"refs/remotes/origin/main": "origin/main",
+		"refs/tags/v1.0":           "v1.0",
+		"refs/stash":               "stash",
+		"HEAD":                     "HEAD",
+	}
+	for ref, want := range tests {
+		if got := BranchName(ref); got != want {
+			t.Errorf("BranchName(%q) = %q, want %q", ref, got, want)
+		}
+	}
+}
+
+func TestCompareTickets(t *testing.T) {
+	keys := []string{"PAY-10", "#87", "OPS-3", "PAY-9", "#9", "PAY-100"}
+	sort.Slice(keys, func(i, j int) bool { return CompareTickets(keys[i], keys[j]) < 0 })
+
+	want := []string{"#9", "#87", "OPS-3", "PAY-9", "PAY-10", "PAY-100"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("sorted = %q, want %q", keys, want)
+	}
+}
+
+func TestGroupCommits_Ticket(t *testing.T) {
+	results := []RepoResult{
+		{Name: "api", Commits: []Commit{
+			{Hash: "a1", Tickets: []string{"PAY-10"}},
+			{Hash: "a2", Tickets: []string{"PAY-9", "PAY-10"}},
+			{Hash: "a3"},
+		}},
+		{Name: "web", Commits: []Commit{
+			{Hash: "w1", Tickets: []string{"PAY-9"}},
+		}},
+	}
+
+	groups, err := GroupCommits(results, GroupByTicket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, hashes := groupKeys(groups)
+
+	if want := []string{"PAY-9", "PAY-10", ""}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %q, want %q", keys, want)
+	}
+	// 티켓이 두 개인 커밋은 두 묶음에 모두 들어간다
+	if want := []string{"api/a2", "web/w1"}; !reflect.DeepEqual(hashes[0], want) {
+		t.Errorf("PAY-9 = %q, want %q", hashes[0], want)
+	}
+	if want := []string{"api/a1", "api/a2"}; !reflect.DeepEqual(hashes[1], want) {
+		t.Errorf("PAY-10 = %q, want %q", hashes[1], want)
+	}
+}
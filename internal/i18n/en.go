@@ -0,0 +1,195 @@
+package i18n
+
+func init() {
+	Register("en", en)
+}
+
+var en = Catalog{
+	"weekday.0": "Sun",
+	"weekday.1": "Mon",
+	"weekday.2": "Tue",
+	"weekday.3": "Wed",
+	"weekday.4": "Thu",
+	"weekday.5": "Fri",
+	"weekday.6": "Sat",
+
+	// 리포트 (터미널/마크다운/HTML)
+	"report.more":       "... +%d more",
+	"report.totals":     "Total %d commits | %d projects | %d files changed | %s",
+	"report.merged":     "⤵ merged %s",
+	"report.merges":     "%d merges",
+	"report.summary":    "📝 Summary",
+	"report.summary_md": "📝 Summary",
+	"report.warnings":   "⚠ Warning: failed to collect logs from %d repos",
+	"report.git_failed": "git failed to run",
+
+	"html.generated": "generated %s",
+	"html.projects":  "projects",
+	"html.by_repo":   "Commits by repo",
+	"html.by_repo_a": "Commit count by repo",
+	"html.by_hour":   "Activity by hour",
+	"html.hour":      "%02d:00",
+	"html.commits":   "Commits",
+
+	// 커밋 묶음 (--group-by type/scope)
+	"group.breaking":      "⚠ Breaking Changes",
+	"group.other":         "Other",
+	"group.no_scope":      "(no scope)",
+	"group.no_ticket":     "(no ticket)",
+	"group.no_branch":     "(no branch)",
+	"group.merges":        "Merges",
+	"group.type.feat":     "Features",
+	"group.type.fix":      "Fixes",
+	"group.type.perf":     "Performance",
+	"group.type.refactor": "Refactoring",
+	"group.type.revert":   "Reverts",
+	"group.type.docs":     "Documentation",
+	"group.type.test":     "Tests",
+	"group.type.build":    "Build",
+	"group.type.ci":       "CI",
+	"group.type.style":    "Style",
+	"group.type.chore":    "Chores",
+
+	// 활동 통계 (--stats)
+	"stats.title":      "⏱ Activity",
+	"stats.span":       "First commit %s · last commit %s",
+	"stats.gap":        "Longest gap %s (%s ~ %s)",
+	"stats.by_hour":    "By hour",
+	"stats.by_weekday": "By weekday",
+	"stats.hour":       "%02d:00",
+	"duration.m":       "%dm",
+	"duration.hm":      "%dh %dm",
+
+	// 히트맵
+	"heatmap.less":    "Less",
+	"heatmap.more":    "More",
+	"heatmap.totals":  "%d commits · %d active days · longest streak %d days",
+	"heatmap.busiest": "Busiest day: %s (%d commits)",
+	"heatmap.no_repo": "no repository matches --repo: %s",
+	"month.1":         "Jan",
+	"month.2":         "Feb",
+	"month.3":         "Mar",
+	"month.4":         "Apr",
+	"month.5":         "May",
+	"month.6":         "Jun",
+	"month.7":         "Jul",
+	"month.8":         "Aug",
+	"month.9":         "Sep",
+	"month.10":        "Oct",
+	"month.11":        "Nov",
+	"month.12":        "Dec",
+
+	// 스탠드업
+	"standup.title":    "🧍 Standup",
+	"standup.done":     "✅ Yesterday",
+	"standup.plan":     "📋 Today",
+	"standup.blockers": "🚧 Blockers",
+
+	// 팀 리포트
+	"team.title":  "👥 Team report",
+	"team.repos":  "📁 %s",
+	"team.others": "%d others: %s",
+	"team.totals": "Total %d commits | %d people | %d projects | %s",
+
+	// TUI
+	"tui.help":           "↑↓ move · enter expand · p period · a author · r reload · s summary · e export · q quit",
+	"tui.filter":         "period %s · author %s",
+	"tui.author_default": "mine",
+	"tui.totals":         "%d commits · %d projects · %d files · +%d -%d",
+	"tui.no_commits":     "No commits in this period.",
+	"tui.no_files":       "(no file details)",
+	"tui.loading":        "Loading...",
+	"tui.summarizing":    "Generating AI summary...",
+	"tui.exported":       "✓ Saved: %s",
+	"tui.error":          "Error: %v",
+	"tui.prompt_period":  "Period (today, yesterday, week, month, last-7d, 2026-02-14): ",
+	"tui.prompt_author":  "Authors, comma-separated (empty for mine, * for all): ",
+	"tui.prompt_export":  "Save to: ",
+
+	// AI 프롬프트
+	"prompt.report": "Below is a developer's Git commit log. Summarize what they worked on today, concisely and in natural language.\n" +
+		"- Summarize the key work for each project in 1-2 sentences\n" +
+		"- Finish with a one-line overall summary\n" +
+		"- Use the change size (+added/-deleted lines) to mention the biggest pieces of work first\n" +
+		"- Write in English\n",
+	"prompt.standup": "Below is the Git commit log a developer has written since the previous working day (%s). Turn it into notes for a daily standup.\n" +
+		"- Write the three sections below in this order with exactly these headings\n" +
+		"  ### Yesterday: the key work per project as 2-4 bullets\n" +
+		"  ### Today: 1-3 bullets guessing what comes next from the commit flow (WIP, TODO, unfinished features), phrased as a guess\n" +
+		"  ### Blockers: anything that looks stuck such as reverts, hotfixes or repeated fixes, or \"None\"\n" +
+		"- Keep it short and concrete, as if speaking; leave out commit hashes and file counts\n" +
+		"- Write in English\n",
+	"prompt.person": "Below is the Git commit log team member %s has written since %s. Summarize it as one paragraph for the team's weekly digest.\n" +
+		"- Write in the third person, covering 2-4 key pieces of work, biggest changes first\n" +
+		"- Include pairing work (commits where they are listed as a co-author)\n" +
+		"- Leave out commit hashes and file counts\n" +
+		"- Write in English\n",
+	"prompt.merges":   "Merged (not counted as commits): %s",
+	"prompt.branches": "Branches: %s",
+	"prompt.types":    "Commit types: %s",
+	"prompt.areas":    "Changed areas: %s",
+
+	// 명령 실행 메시지
+	"cmd.no_repos":           "No Git repositories found. Run gitday init and set scan_paths.",
+	"cmd.no_commits":         "📭 No commits between %s and %s.",
+	"cmd.scan_failed":        "failed to scan repositories: %w",
+	"cmd.collect_failed":     "failed to collect commit logs: %w",
+	"cmd.strict":             "failed to collect logs from %d repos (--strict)",
+	"cmd.config_error":       "config error: %w",
+	"cmd.standup_config":     "standup config error: %w",
+	"cmd.unsupported_format": "unsupported output format: %s (%s)",
+	"cmd.format_template":    "--format and --template cannot be used together",
+	"cmd.markdown_template":  "--markdown and --template cannot be used together",
+	"cmd.ai_generating":      "📝 Generating AI summary (%s)...",
+	"cmd.ai_failed":          "⚠ AI summary failed: %v",
+	"cmd.ai_person":          "📝 Summarizing %s (%s)...",
+	"cmd.team_config":        "team config error: %w",
+	"cmd.log_saved":          "✓ Saved: %s",
+	"cmd.log_save_failed":    "⚠ Failed to save log: %v",
+	"cmd.export_empty":       "No commits to export.",
+	"cmd.export_saved":       "✓ Report saved: %s",
+	"cmd.write_failed":       "failed to write file: %w",
+	"cmd.send_target":        "specify where to send the report (e.g. --slack)",
+	"cmd.send_webhook":       "Slack webhook URL is not set. Configure it in ~/.gitday.yaml",
+	"cmd.send_empty":         "No commits to send.",
+	"cmd.send_done":          "✓ Sent to Slack!",
+	"cmd.home_failed":        "failed to find home directory: %w",
+	"cmd.init_exists":        "⚠ Config file already exists: %s",
+	"cmd.init_overwrite":     "Overwrite? (y/N): ",
+	"cmd.init_cancelled":     "Cancelled.",
+	"cmd.init_failed":        "failed to create config file: %w",
+	"cmd.init_created":       "✓ Config file created: %s",
+	"cmd.init_hint":          "  Edit scan_paths to choose which directories to scan.",
+
+	// 에러 (internal 패키지)
+	"err.language":       "unsupported language: %s (%s)",
+	"err.timezone":       "unknown timezone: %s",
+	"err.day_start":      "invalid day_start: %s (e.g. 04:00)",
+	"err.period_count":   "period length must be at least 1: %s",
+	"err.period_unknown": "unknown period: %s (today, yesterday, week, month, last-7d, last-6m, \"3 days ago\", 2006-01-02)",
+	"err.range_order":    "start (%s) must be before end (%s)",
+	"err.time_parse":     "cannot parse time: %s (2006-01-02, \"3 days ago\", yesterday)",
+	"err.weekday":        "unknown weekday: %s (mon, tue, ... sun)",
+	"err.holiday":        "invalid holiday date: %s (2006-01-02)",
+	"err.sort":           "unsupported sort: %s (name/commits/churn/recent)",
+	"err.team_name":      "team member name is empty (team[].name)",
+	"err.team_duplicate": "duplicate team member name: %s",
+	"err.ticket_pattern": "invalid ticket pattern %q: %w",
+	"err.merges":         "unsupported merges mode: %s (show/hide/collapse)",
+	"err.group_by":       "unsupported group-by: %s (repo/type/scope/ticket/branch)",
+	"err.commit_order":   "unsupported commit order: %s (asc/desc)",
+	"err.git_failed":     "git log failed in %d repositories",
+	"err.format":         "unsupported output format: %s (text/markdown/json/ndjson/html/csv)",
+	"err.csv_layout":     "unsupported CSV layout: %s (timesheet/commits)",
+	"err.template_read":  "failed to read template: %w",
+	"err.template_parse": "failed to parse template: %w",
+	"err.template_exec":  "failed to execute template: %w",
+	"err.api_key":        "%s API key is required (GITDAY_API_KEY environment variable or config file)",
+	"err.provider":       "unsupported AI provider: %s (claude/openai/ollama)",
+	"err.api_call":       "%s API request failed: %w",
+	"err.api_status":     "%s API error (%d): %s",
+	"err.api_empty":      "%s returned an empty response",
+	"err.ollama_connect": "failed to connect to Ollama (%s): %w",
+	"err.slack_send":     "failed to send Slack webhook: %w",
+	"err.slack_status":   "Slack error (%d): %s",
+}
@@ -0,0 +1,120 @@
+// Package i18n은 사용자에게 보이는 문구(터미널/마크다운/HTML 라벨, 요일, AI 프롬프트 지시문)를
+// 언어별 메시지 카탈로그로 관리한다.
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLanguage는 language 설정이 없을 때 쓰는 언어이다.
+// 카탈로그에 없는 키도 이 언어의 문구로 대신한다.
+const DefaultLanguage = "ko"
+
+// Catalog는 메시지 키 → 문구 매핑이다. 문구는 fmt.Sprintf 형식 지정자를 포함할 수 있다.
+type Catalog map[string]string
+
+var (
+	mu       sync.RWMutex
+	catalogs = map[string]Catalog{}
+	current  = DefaultLanguage
+)
+
+// Register는 언어 카탈로그를 등록한다. 같은 언어가 이미 있으면 키 단위로 덮어쓴다.
+// 새 언어는 이 패키지에 카탈로그 파일을 추가하고 init에서 Register하면 된다.
+func Register(lang string, c Catalog) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	lang = strings.ToLower(lang)
+	if catalogs[lang] == nil {
+		catalogs[lang] = Catalog{}
+	}
+	for k, v := range c {
+		catalogs[lang][k] = v
+	}
+}
+
+// SetLanguage는 현재 언어를 바꾼다. 빈 문자열이면 DefaultLanguage를 쓴다.
+// "en_US.UTF-8", "en-GB"처럼 지역/인코딩이 붙은 값은 언어 부분만 본다.
+func SetLanguage(lang string) error {
+	lang = normalize(lang)
+	if lang == "" {
+		lang = DefaultLanguage
+	}
+
+	mu.Lock()
+	_, ok := catalogs[lang]
+	if ok {
+		current = lang
+	}
+	mu.Unlock()
+
+	if !ok {
+		return Errorf("err.language", lang, strings.Join(Languages(), "/"))
+	}
+	return nil
+}
+
+// Language는 현재 언어를 반환한다.
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Languages는 등록된 언어 목록을 정렬해 반환한다.
+func Languages() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return languagesLocked()
+}
+
+func languagesLocked() []string {
+	langs := make([]string, 0, len(catalogs))
+	for l := range catalogs {
+		langs = append(langs, l)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// T는 현재 언어로 메시지를 찾아 args로 포맷한다.
+// 현재 언어에 없는 키는 DefaultLanguage에서, 그래도 없으면 키 자체를 돌려준다.
+func T(key string, args ...any) string {
+	mu.RLock()
+	msg, ok := catalogs[current][key]
+	if !ok {
+		msg, ok = catalogs[DefaultLanguage][key]
+	}
+	mu.RUnlock()
+
+	if !ok {
+		msg = key
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Errorf는 현재 언어의 메시지(key)로 에러를 만든다. 메시지에 %w가 있으면 args의 에러를 감싼다.
+func Errorf(key string, args ...any) error {
+	return fmt.Errorf(T(key), args...)
+}
+
+// Weekday는 현재 언어의 짧은 요일 이름을 반환한다 (예: "목", "Thu").
+func Weekday(w time.Weekday) string {
+	return T(fmt.Sprintf("weekday.%d", int(w)))
+}
+
+func normalize(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "_-."); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
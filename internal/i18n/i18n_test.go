@@ -0,0 +1,101 @@
+package i18n
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCatalogsHaveSameKeys(t *testing.T) {
+	for _, lang := range Languages() {
+		if lang == DefaultLanguage {
+			continue
+		}
+		for key := range catalogs[DefaultLanguage] {
+			if _, ok := catalogs[lang][key]; !ok {
+				t.Errorf("%s: missing key %q", lang, key)
+			}
+		}
+		for key := range catalogs[lang] {
+			if _, ok := catalogs[DefaultLanguage][key]; !ok {
+				t.Errorf("%s: unknown key %q", lang, key)
+			}
+		}
+	}
+}
+
+func TestSetLanguage(t *testing.T) {
+	defer SetLanguage(DefaultLanguage)
+
+	for _, in := range []string{"en", "EN", "en_US.UTF-8", "en-GB"} {
+		if err := SetLanguage(in); err != nil {
+			t.Fatalf("SetLanguage(%q): %v", in, err)
+		}
+		if Language() != "en" {
+			t.Errorf("SetLanguage(%q) → %s", in, Language())
+		}
+	}
+	if got := Weekday(time.Thursday); got != "Thu" {
+		t.Errorf("Weekday = %q", got)
+	}
+
+	if err := SetLanguage("xx"); err == nil {
+		t.Error("unknown language should fail")
+	}
+	if Language() != "en" {
+		t.Error("failed SetLanguage should keep the current language")
+	}
+
+	if err := SetLanguage(""); err != nil || Language() != DefaultLanguage {
+		t.Errorf("empty language → %s, %v", Language(), err)
+	}
+	if got := Weekday(time.Thursday); got != "목" {
+		t.Errorf("Weekday = %q", got)
+	}
+}
+
+func TestTFallback(t *testing.T) {
+	defer SetLanguage(DefaultLanguage)
+
+	Register("zz", Catalog{"weekday.0": "Z"})
+	if err := SetLanguage("zz"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		mu.Lock()
+		delete(catalogs, "zz")
+		mu.Unlock()
+	}()
+
+	if got := T("weekday.0"); got != "Z" {
+		t.Errorf("T = %q", got)
+	}
+	if got := T("weekday.1"); got != "월" {
+		t.Errorf("fallback T = %q", got)
+	}
+	if got := T("no.such.key"); got != "no.such.key" {
+		t.Errorf("missing key T = %q", got)
+	}
+	if got := T("report.more", 3); got != "... +3 more" {
+		t.Errorf("formatted T = %q", got)
+	}
+}
+
+func TestErrorf(t *testing.T) {
+	defer SetLanguage(DefaultLanguage)
+
+	cause := errors.New("boom")
+	err := Errorf("err.template_read", cause)
+	if err.Error() != "템플릿 읽기 실패: boom" || !errors.Is(err, cause) {
+		t.Errorf("Errorf = %v (wraps cause: %v)", err, errors.Is(err, cause))
+	}
+
+	SetLanguage("en")
+	if err := Errorf("err.sort", "size"); err.Error() != "unsupported sort: size (name/commits/churn/recent)" {
+		t.Errorf("en Errorf = %v", err)
+	}
+	if err := SetLanguage("xx"); err == nil || !strings.HasPrefix(err.Error(), "unsupported language: xx") {
+		t.Errorf("SetLanguage(xx) = %v, want error in the current language", err)
+	}
+}
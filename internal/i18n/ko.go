@@ -0,0 +1,195 @@
+package i18n
+
+func init() {
+	Register("ko", ko)
+}
+
+var ko = Catalog{
+	"weekday.0": "일",
+	"weekday.1": "월",
+	"weekday.2": "화",
+	"weekday.3": "수",
+	"weekday.4": "목",
+	"weekday.5": "금",
+	"weekday.6": "토",
+
+	// 리포트 (터미널/마크다운/HTML)
+	"report.more":       "... +%d more",
+	"report.totals":     "총 %d commits | %d개 프로젝트 | %d files changed | %s",
+	"report.merged":     "⤵ 병합 %s",
+	"report.merges":     "병합 %d건",
+	"report.summary":    "📝 오늘의 요약",
+	"report.summary_md": "📝 요약",
+	"report.warnings":   "⚠ 경고: %d개 레포에서 로그 수집 실패",
+	"report.git_failed": "git 실행 실패",
+
+	"html.generated": "생성 %s",
+	"html.projects":  "프로젝트",
+	"html.by_repo":   "레포별 커밋",
+	"html.by_repo_a": "레포별 커밋 수",
+	"html.by_hour":   "시간대별 활동",
+	"html.hour":      "%d시",
+	"html.commits":   "커밋",
+
+	// 커밋 묶음 (--group-by type/scope)
+	"group.breaking":      "⚠ 호환성이 깨지는 변경",
+	"group.other":         "기타",
+	"group.no_scope":      "(범위 없음)",
+	"group.no_ticket":     "(티켓 없음)",
+	"group.no_branch":     "(브랜치 없음)",
+	"group.merges":        "병합",
+	"group.type.feat":     "기능",
+	"group.type.fix":      "버그 수정",
+	"group.type.perf":     "성능 개선",
+	"group.type.refactor": "리팩터링",
+	"group.type.revert":   "되돌림",
+	"group.type.docs":     "문서",
+	"group.type.test":     "테스트",
+	"group.type.build":    "빌드",
+	"group.type.ci":       "CI",
+	"group.type.style":    "스타일",
+	"group.type.chore":    "기타 작업",
+
+	// 활동 통계 (--stats)
+	"stats.title":      "⏱ 활동 통계",
+	"stats.span":       "첫 커밋 %s · 마지막 커밋 %s",
+	"stats.gap":        "최장 공백 %s (%s ~ %s)",
+	"stats.by_hour":    "시간대별",
+	"stats.by_weekday": "요일별",
+	"stats.hour":       "%02d시",
+	"duration.m":       "%d분",
+	"duration.hm":      "%d시간 %d분",
+
+	// 히트맵
+	"heatmap.less":    "적음",
+	"heatmap.more":    "많음",
+	"heatmap.totals":  "총 %d commits · 활동 %d일 · 최장 연속 %d일",
+	"heatmap.busiest": "가장 바쁜 날: %s (%d commits)",
+	"heatmap.no_repo": "--repo와 일치하는 레포가 없습니다: %s",
+	"month.1":         "1월",
+	"month.2":         "2월",
+	"month.3":         "3월",
+	"month.4":         "4월",
+	"month.5":         "5월",
+	"month.6":         "6월",
+	"month.7":         "7월",
+	"month.8":         "8월",
+	"month.9":         "9월",
+	"month.10":        "10월",
+	"month.11":        "11월",
+	"month.12":        "12월",
+
+	// 스탠드업
+	"standup.title":    "🧍 스탠드업",
+	"standup.done":     "✅ 어제 한 일",
+	"standup.plan":     "📋 오늘 할 일",
+	"standup.blockers": "🚧 블로커",
+
+	// 팀 리포트
+	"team.title":  "👥 팀 리포트",
+	"team.repos":  "📁 %s",
+	"team.others": "그 외 %d명: %s",
+	"team.totals": "총 %d commits | %d명 | %d개 프로젝트 | %s",
+
+	// TUI
+	"tui.help":           "↑↓ 이동 · enter 펼치기 · p 기간 · a 작성자 · r 새로고침 · s 요약 · e 내보내기 · q 종료",
+	"tui.filter":         "기간 %s · 작성자 %s",
+	"tui.author_default": "내 커밋",
+	"tui.totals":         "%d commits · %d개 프로젝트 · %d files · +%d -%d",
+	"tui.no_commits":     "이 기간에 커밋이 없습니다.",
+	"tui.no_files":       "(파일 정보 없음)",
+	"tui.loading":        "불러오는 중...",
+	"tui.summarizing":    "AI 요약 생성 중...",
+	"tui.exported":       "✓ 저장됨: %s",
+	"tui.error":          "오류: %v",
+	"tui.prompt_period":  "기간 (today, yesterday, week, month, last-7d, 2026-02-14): ",
+	"tui.prompt_author":  "작성자, 쉼표로 여러 명 (비우면 내 커밋, *는 전체): ",
+	"tui.prompt_export":  "저장할 경로: ",
+
+	// AI 프롬프트
+	"prompt.report": "다음은 개발자의 Git 커밋 로그입니다. 이 내용을 바탕으로 오늘 한 일을 자연어로 간결하게 요약해주세요.\n" +
+		"- 프로젝트별로 핵심 작업을 1-2문장으로 요약\n" +
+		"- 마지막에 전체적인 한줄 요약 추가\n" +
+		"- 변경 규모(+추가/-삭제 라인)를 참고해 비중이 큰 작업을 우선 언급\n" +
+		"- 한국어로 작성\n",
+	"prompt.standup": "다음은 개발자가 직전 근무일(%s)부터 지금까지 남긴 Git 커밋 로그입니다. 데일리 스탠드업 발표용으로 정리해주세요.\n" +
+		"- 아래 세 섹션을 이 순서와 제목 그대로 작성\n" +
+		"  ### 어제 한 일: 프로젝트별 핵심 작업을 bullet 2-4개로\n" +
+		"  ### 오늘 할 일: 커밋 흐름상 이어질 작업(WIP, TODO, 미완성 기능)을 추정해 bullet 1-3개로, 추정임을 드러내는 표현 사용\n" +
+		"  ### 블로커: revert, hotfix, 반복된 fix 등 막힌 흔적이 있으면 적고 없으면 \"없음\"\n" +
+		"- 말하듯 짧고 구체적으로, 커밋 해시나 파일 수는 생략\n" +
+		"- 한국어로 작성\n",
+	"prompt.person": "다음은 팀원 %s가 %s부터 남긴 Git 커밋 로그입니다. 팀 주간 다이제스트에 들어갈 한 문단으로 요약해주세요.\n" +
+		"- 3인칭으로, 핵심 작업 2-4개를 변경 규모가 큰 것부터\n" +
+		"- 공동 작업(다른 사람의 커밋에 공동 작성자로 들어간 것)도 포함\n" +
+		"- 커밋 해시나 파일 수는 생략\n" +
+		"- 한국어로 작성\n",
+	"prompt.merges":   "병합 (커밋 수에서 제외): %s",
+	"prompt.branches": "브랜치: %s",
+	"prompt.types":    "커밋 유형: %s",
+	"prompt.areas":    "변경 영역: %s",
+
+	// 명령 실행 메시지
+	"cmd.no_repos":           "스캔된 Git 레포가 없습니다. gitday init으로 scan_paths를 설정하세요.",
+	"cmd.no_commits":         "📭 %s ~ %s 기간에 커밋이 없습니다.",
+	"cmd.scan_failed":        "레포 스캔 실패: %w",
+	"cmd.collect_failed":     "커밋 로그 수집 실패: %w",
+	"cmd.strict":             "%d개 레포에서 로그 수집 실패 (--strict)",
+	"cmd.config_error":       "설정 오류: %w",
+	"cmd.standup_config":     "standup 설정 오류: %w",
+	"cmd.unsupported_format": "지원하지 않는 출력 형식: %s (%s)",
+	"cmd.format_template":    "--format과 --template은 함께 쓸 수 없습니다",
+	"cmd.markdown_template":  "--markdown과 --template은 함께 쓸 수 없습니다",
+	"cmd.ai_generating":      "📝 AI 요약 생성 중 (%s)...",
+	"cmd.ai_failed":          "⚠ AI 요약 실패: %v",
+	"cmd.ai_person":          "📝 %s 요약 생성 중 (%s)...",
+	"cmd.team_config":        "team 설정 오류: %w",
+	"cmd.log_saved":          "✓ 저장됨: %s",
+	"cmd.log_save_failed":    "⚠ 로그 저장 실패: %v",
+	"cmd.export_empty":       "내보낼 커밋이 없습니다.",
+	"cmd.export_saved":       "✓ 리포트 저장됨: %s",
+	"cmd.write_failed":       "파일 저장 실패: %w",
+	"cmd.send_target":        "전송 대상을 지정하세요 (예: --slack)",
+	"cmd.send_webhook":       "Slack webhook URL이 설정되지 않았습니다. ~/.gitday.yaml에서 설정하세요",
+	"cmd.send_empty":         "전송할 커밋이 없습니다.",
+	"cmd.send_done":          "✓ Slack 전송 완료!",
+	"cmd.home_failed":        "홈 디렉토리 확인 실패: %w",
+	"cmd.init_exists":        "⚠ 이미 설정 파일이 존재합니다: %s",
+	"cmd.init_overwrite":     "덮어쓰시겠습니까? (y/N): ",
+	"cmd.init_cancelled":     "취소되었습니다.",
+	"cmd.init_failed":        "설정 파일 생성 실패: %w",
+	"cmd.init_created":       "✓ 설정 파일 생성됨: %s",
+	"cmd.init_hint":          "  scan_paths를 수정하여 스캔할 디렉토리를 지정하세요.",
+
+	// 에러 (internal 패키지)
+	"err.language":       "지원하지 않는 언어: %s (%s)",
+	"err.timezone":       "알 수 없는 타임존: %s",
+	"err.day_start":      "day_start 형식이 잘못되었습니다: %s (예: 04:00)",
+	"err.period_count":   "기간은 1 이상이어야 합니다: %s",
+	"err.period_unknown": "알 수 없는 기간: %s (today, yesterday, week, month, last-7d, last-6m, \"3 days ago\", 2006-01-02)",
+	"err.range_order":    "시작 시각(%s)이 종료 시각(%s)보다 앞서야 합니다",
+	"err.time_parse":     "시각을 해석할 수 없습니다: %s (2006-01-02, \"3 days ago\", yesterday)",
+	"err.weekday":        "알 수 없는 요일: %s (mon, tue, ... sun)",
+	"err.holiday":        "휴일 날짜 형식이 잘못되었습니다: %s (2006-01-02)",
+	"err.sort":           "지원하지 않는 정렬 기준: %s (name/commits/churn/recent)",
+	"err.team_name":      "팀원 이름이 비어 있습니다 (team[].name)",
+	"err.team_duplicate": "팀원 이름이 중복되었습니다: %s",
+	"err.ticket_pattern": "잘못된 티켓 패턴 %q: %w",
+	"err.merges":         "지원하지 않는 병합 커밋 처리 방식: %s (show/hide/collapse)",
+	"err.group_by":       "지원하지 않는 묶음 기준: %s (repo/type/scope/ticket/branch)",
+	"err.commit_order":   "지원하지 않는 커밋 정렬 순서: %s (asc/desc)",
+	"err.git_failed":     "%d개 레포에서 git log 실패",
+	"err.format":         "지원하지 않는 출력 형식: %s (text/markdown/json/ndjson/html/csv)",
+	"err.csv_layout":     "지원하지 않는 CSV 레이아웃: %s (timesheet/commits)",
+	"err.template_read":  "템플릿 읽기 실패: %w",
+	"err.template_parse": "템플릿 파싱 실패: %w",
+	"err.template_exec":  "템플릿 실행 실패: %w",
+	"err.api_key":        "%s API 키가 필요합니다 (GITDAY_API_KEY 환경변수 또는 설정 파일)",
+	"err.provider":       "지원하지 않는 AI 프로바이더: %s (claude/openai/ollama)",
+	"err.api_call":       "%s API 호출 실패: %w",
+	"err.api_status":     "%s API 에러 (%d): %s",
+	"err.api_empty":      "%s 응답이 비어있습니다",
+	"err.ollama_connect": "Ollama 연결 실패 (%s): %w",
+	"err.slack_send":     "Slack 웹훅 전송 실패: %w",
+	"err.slack_status":   "Slack 에러 (%d): %s",
+}
@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/kso1204/gitday/internal/i18n"
 )
 
 // SendSlack은 Slack 웹훅으로 메시지를 전송한다.
@@ -25,13 +26,13 @@ func SendSlack(ctx context.Context, webhookURL, text string) error {
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("Slack 웹훅 전송 실패: %w", err)
+		return i18n.Errorf("err.slack_send", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("Slack 에러 (%d): %s", resp.StatusCode, string(respBody))
+		return i18n.Errorf("err.slack_status", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -0,0 +1,160 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/kso1204/gitday/internal/stats"
+)
+
+// maxHistogramBar는 활동 통계 막대의 최대 길이(칸)이다.
+const maxHistogramBar = 30
+
+// activityText는 활동 통계를 터미널/마크다운/HTML이 같이 쓰는 평문 줄로 나눈 것이다.
+// HTML 템플릿에서 읽을 수 있게 필드를 내보낸다.
+type activityText struct {
+	Info     []string // 첫/마지막 커밋, 최장 공백
+	Hours    []string // 시간대별 막대
+	Weekdays []string // 요일별 막대 (여러 날짜 기간일 때만)
+}
+
+func newActivityText(results []git.RepoResult, rng period.Range) activityText {
+	a := stats.NewActivity(results, rng.Calendar())
+	var t activityText
+	if a.Commits == 0 {
+		return t
+	}
+
+	layout := "15:04"
+	if rng.MultiDay() {
+		layout = "01-02 15:04"
+	}
+	t.Info = append(t.Info, i18n.T("stats.span", a.First.Format(layout), a.Last.Format(layout)))
+	if gap := a.LongestGap; gap.Duration() > 0 {
+		t.Info = append(t.Info, i18n.T("stats.gap", formatDuration(gap.Duration()),
+			gap.Start.Format(layout), gap.End.Format(layout)))
+	}
+
+	// 시간대: 첫 활동 시각부터 마지막 활동 시각까지
+	first, last := -1, -1
+	maxHour := 0
+	for h, n := range a.ByHour {
+		if n > 0 {
+			if first < 0 {
+				first = h
+			}
+			last = h
+			maxHour = max(maxHour, n)
+		}
+	}
+	var labels, bars []string
+	for h := first; h <= last; h++ {
+		labels = append(labels, i18n.T("stats.hour", h))
+		bars = append(bars, histogramBar(a.ByHour[h], maxHour))
+	}
+	t.Hours = histogramLines(labels, bars, a.ByHour[first:last+1])
+
+	if rng.MultiDay() {
+		maxDay := 0
+		for _, n := range a.ByWeekday {
+			maxDay = max(maxDay, n)
+		}
+		labels, bars = nil, nil
+		counts := make([]int, 7)
+		for row := 0; row < 7; row++ {
+			w := weekdayOfRow(row)
+			labels = append(labels, i18n.Weekday(w))
+			bars = append(bars, histogramBar(a.ByWeekday[w], maxDay))
+			counts[row] = a.ByWeekday[w]
+		}
+		t.Weekdays = histogramLines(labels, bars, counts)
+	}
+	return t
+}
+
+// histogramBar는 n을 최댓값 대비 길이의 막대로 만든다. 0이 아니면 최소 한 칸이다.
+func histogramBar(n, maxN int) string {
+	if n == 0 || maxN == 0 {
+		return ""
+	}
+	return strings.Repeat("█", max(n*maxHistogramBar/maxN, 1))
+}
+
+// histogramLines는 "09시 ██████ 6" 형태로 라벨과 막대 열을 맞춘다.
+func histogramLines(labels, bars []string, counts []int) []string {
+	labelWidth, barWidth := 0, 0
+	for i := range labels {
+		labelWidth = max(labelWidth, ansi.StringWidth(labels[i]))
+		barWidth = max(barWidth, ansi.StringWidth(bars[i]))
+	}
+	lines := make([]string, len(labels))
+	for i := range labels {
+		lines[i] = fmt.Sprintf("%s %s %d", padRight(labels[i], labelWidth), padRight(bars[i], barWidth), counts[i])
+	}
+	return lines
+}
+
+// formatDuration은 공백 시간을 "3시간 20분" / "3h 20m" 형태로 포맷한다.
+func formatDuration(d time.Duration) string {
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes < 60 {
+		return i18n.T("duration.m", minutes)
+	}
+	return i18n.T("duration.hm", minutes/60, minutes%60)
+}
+
+// printActivity는 PrintReport 아래에 활동 통계 섹션을 출력한다 (--stats).
+func printActivity(results []git.RepoResult, rng period.Range) {
+	t := newActivityText(results, rng)
+	if len(t.Info) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(outStyles.summaryHeader.Render(i18n.T("stats.title")))
+	for _, line := range t.Info {
+		fmt.Println(commitIndent + line)
+	}
+	for _, section := range []struct {
+		title string
+		lines []string
+	}{{i18n.T("stats.by_hour"), t.Hours}, {i18n.T("stats.by_weekday"), t.Weekdays}} {
+		if len(section.lines) == 0 {
+			continue
+		}
+		fmt.Println()
+		fmt.Println(commitIndent + outStyles.repo.Render(section.title))
+		for _, line := range section.lines {
+			fmt.Println(commitIndent + outStyles.stat.Render(line))
+		}
+	}
+}
+
+// activityMarkdown은 활동 통계 섹션을 마크다운으로 만든다 (--stats).
+func activityMarkdown(results []git.RepoResult, rng period.Range) string {
+	t := newActivityText(results, rng)
+	if len(t.Info) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\n## %s\n\n", i18n.T("stats.title")))
+	for _, line := range t.Info {
+		sb.WriteString("- " + line + "\n")
+	}
+	for _, section := range []struct {
+		title string
+		lines []string
+	}{{i18n.T("stats.by_hour"), t.Hours}, {i18n.T("stats.by_weekday"), t.Weekdays}} {
+		if len(section.lines) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\n**%s**\n\n```\n%s\n```\n", section.title, strings.Join(section.lines, "\n")))
+	}
+	return sb.String()
+}
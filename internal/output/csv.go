@@ -0,0 +1,90 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/kso1204/gitday/internal/stats"
+)
+
+// CSV 레이아웃
+const (
+	LayoutTimesheet = "timesheet" // 레포 × 날짜별 한 줄 (기본)
+	LayoutCommits   = "commits"   // 커밋마다 한 줄
+)
+
+// ToCSV는 리포트를 CSV로 변환한다.
+// timesheet 레이아웃은 레포 × 날짜별로 커밋 수, 변경량, 첫/마지막 커밋 시각, 추정 작업 시간을 담고,
+// commits 레이아웃은 커밋마다 한 줄을 쓴다. 날짜는 기간의 타임존/day_start 기준이다.
+func ToCSV(results []git.RepoResult, rng period.Range, opts Options) ([]byte, error) {
+	var rows [][]string
+
+	switch opts.Layout {
+	case "", LayoutTimesheet:
+		rows = timesheetRows(results, rng, opts.Timesheet)
+	case LayoutCommits:
+		rows = commitRows(results, rng)
+	default:
+		return nil, i18n.Errorf("err.csv_layout", opts.Layout)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func timesheetRows(results []git.RepoResult, rng period.Range, opts stats.TimesheetOptions) [][]string {
+	rows := [][]string{{
+		"date", "repo", "path", "commits", "files", "insertions", "deletions",
+		"first_commit", "last_commit", "worked_minutes", "worked_hours",
+	}}
+
+	for _, r := range stats.Timesheet(results, rng.Calendar(), opts) {
+		rows = append(rows, []string{
+			r.Date.Format("2006-01-02"),
+			r.Repo,
+			r.Path,
+			strconv.Itoa(r.Commits),
+			strconv.Itoa(r.Files),
+			strconv.Itoa(r.Insertions),
+			strconv.Itoa(r.Deletions),
+			r.First.Format("15:04"),
+			r.Last.Format("15:04"),
+			strconv.Itoa(int(r.Worked / time.Minute)),
+			strconv.FormatFloat(r.Worked.Hours(), 'f', 2, 64),
+		})
+	}
+	return rows
+}
+
+func commitRows(results []git.RepoResult, rng period.Range) [][]string {
+	cal := rng.Calendar()
+	rows := [][]string{{
+		"date", "time", "repo", "hash", "author", "message", "files", "insertions", "deletions",
+	}}
+
+	for _, r := range results {
+		for _, c := range r.Commits {
+			rows = append(rows, []string{
+				cal.StartOfDay(c.Date).Format("2006-01-02"),
+				cal.In(c.Date).Format("15:04"),
+				r.Name,
+				c.Hash,
+				c.Author,
+				c.Message,
+				strconv.Itoa(c.Files),
+				strconv.Itoa(c.Insertions),
+				strconv.Itoa(c.Deletions),
+			})
+		}
+	}
+	return rows
+}
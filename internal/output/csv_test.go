@@ -0,0 +1,47 @@
+package output
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+func TestToCSVTimesheet(t *testing.T) {
+	results, rng := jsonFixture()
+
+	data, err := ToCSV(results, rng, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("rows = %d, want header + 1", len(rows))
+	}
+	want := []string{"2026-02-26", "rpg", "/work/rpg", "1", "2", "30", "4", "15:00", "15:00", "30", "0.50"}
+	if strings.Join(rows[1], ",") != strings.Join(want, ",") {
+		t.Errorf("row = %v, want %v", rows[1], want)
+	}
+}
+
+func TestToCSVCommits(t *testing.T) {
+	results, rng := jsonFixture()
+
+	data, err := ToCSV(results, rng, Options{Layout: LayoutCommits})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[1][3] != "dbc7067" || rows[1][5] != "전투 <버그> 수정" {
+		t.Errorf("rows = %v", rows)
+	}
+
+	if _, err := ToCSV(results, rng, Options{Layout: "weird"}); err == nil {
+		t.Error("unknown layout should fail")
+	}
+}
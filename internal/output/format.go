@@ -0,0 +1,43 @@
+package output
+
+import (
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// 출력 형식
+const (
+	FormatText     = "text"     // 터미널 (PrintReport)
+	FormatMarkdown = "markdown" // ToMarkdown
+	FormatJSON     = "json"     // ToJSON
+	FormatNDJSON   = "ndjson"   // ToNDJSON
+	FormatHTML     = "html"     // ToHTML
+	FormatCSV      = "csv"      // ToCSV
+	FormatSVG      = "svg"      // HeatmapSVG (heatmap 전용)
+)
+
+// IsMachineFormat은 스크립트가 읽는 형식인지 여부이다.
+// 이런 형식에서는 진행 메시지나 "커밋 없음" 안내를 stdout에 섞지 않는다.
+func IsMachineFormat(format string) bool {
+	return format == FormatJSON || format == FormatNDJSON || format == FormatCSV
+}
+
+// Render는 문서 형식(markdown/json/ndjson/html/csv) 리포트를 만든다.
+// 터미널 형식(text)은 PrintReport로 직접 출력하므로 여기서 다루지 않는다.
+func Render(format string, results []git.RepoResult, rng period.Range, summary string, opts Options) ([]byte, error) {
+	switch format {
+	case FormatMarkdown, "md":
+		return []byte(ToMarkdown(results, rng, summary, opts)), nil
+	case FormatJSON:
+		return ToJSON(results, rng, summary, opts)
+	case FormatNDJSON:
+		return ToNDJSON(results, rng, summary, opts)
+	case FormatHTML:
+		return ToHTML(results, rng, summary, opts)
+	case FormatCSV:
+		return ToCSV(results, rng, opts)
+	default:
+		return nil, i18n.Errorf("err.format", format)
+	}
+}
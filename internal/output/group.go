@@ -0,0 +1,132 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// reportSection은 리포트의 한 덩어리(레포 또는 --group-by 묶음)이다.
+type reportSection struct {
+	title   string       // 헤더 제목 (커밋 수/변경량은 출력할 때 붙인다)
+	commits []git.Commit // 출력할 커밋. 묶음이면 메시지를 묶음에 맞게 바꾼 사본이다
+	merges  string       // merges: collapse로 뗀 병합 커밋 요약 ("PR #12, main ×2"). 묶음에서는 마지막 병합 섹션에만 있다
+}
+
+// heading은 "제목 (3 commits, +10 -2)" 형태의 섹션 헤더이다. 병합 커밋만 있는 섹션은 제목만 쓴다.
+func (sec reportSection) heading() string {
+	if len(sec.commits) == 0 && sec.merges != "" {
+		return sec.title
+	}
+	return fmt.Sprintf("%s (%d commits, %s)", sec.title, len(sec.commits), formatChurn(sectionChurn(sec.commits)))
+}
+
+// reportSections는 결과를 묶음 기준(by)에 따라 리포트 섹션으로 나눈다.
+// 기준이 repo(기본)면 레포마다 한 섹션이고, type/scope/ticket이면 레포를 가로질러 묶는다.
+// 여러 레포의 커밋이 섞이는 묶음에서는 메시지 앞에 레포 이름을 붙이고,
+// 레포 안에서 묶는 branch는 제목 앞에 레포 이름을 붙인다.
+func reportSections(results []git.RepoResult, by string) []reportSection {
+	if by == "" || by == git.GroupByRepo {
+		sections := make([]reportSection, len(results))
+		for i, r := range results {
+			sections[i] = reportSection{title: repoTitle(r), commits: r.Commits, merges: git.MergeSummary(r.Merges)}
+		}
+		return sections
+	}
+
+	groups, err := git.GroupCommits(results, by)
+	if err != nil {
+		// 기준 값은 cmd에서 미리 검사한다. 여기까지 오면 레포별로 보여준다
+		return reportSections(results, git.GroupByRepo)
+	}
+
+	sections := make([]reportSection, len(groups))
+	for i, g := range groups {
+		commits := make([]git.Commit, len(g.Commits))
+		for j, gc := range g.Commits {
+			c := gc.Commit
+			c.Message = git.GroupedMessage(c, g.Key, by)
+			if len(results) > 1 && g.Repo == "" {
+				c.Message = "[" + gc.Repo + "] " + c.Message
+			}
+			commits[j] = c
+		}
+		title := groupTitle(g.Key, by)
+		if g.Repo != "" && len(results) > 1 {
+			title = g.Repo + " › " + title
+		}
+		sections[i] = reportSection{title: title, commits: commits}
+	}
+
+	// 병합 커밋은 어느 묶음에도 속하지 않으므로 마지막에 따로 모아 하단 통계의 병합 수와 맞춘다
+	if merges := groupedMerges(results); merges != "" {
+		sections = append(sections, reportSection{title: i18n.T("group.merges"), merges: merges})
+	}
+	return sections
+}
+
+// groupedMerges는 레포마다 병합 커밋 요약을 만들어 잇는다. 레포가 여럿이면 앞에 [레포]를 붙인다.
+func groupedMerges(results []git.RepoResult) string {
+	var parts []string
+	for _, r := range results {
+		if len(r.Merges) == 0 {
+			continue
+		}
+		summary := git.MergeSummary(r.Merges)
+		if len(results) > 1 {
+			summary = "[" + r.Name + "] " + summary
+		}
+		parts = append(parts, summary)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// groupTitle은 묶음 헤더 제목이다. 알려진 유형은 "Features"처럼 풀어 쓰고, 나머지는 값 그대로이다.
+func groupTitle(key, by string) string {
+	switch {
+	case by == git.GroupByType && key == git.GroupBreaking:
+		return i18n.T("group.breaking")
+	case by == git.GroupByType && key == "":
+		return i18n.T("group.other")
+	case by == git.GroupByType:
+		if title := i18n.T("group.type." + key); title != "group.type."+key {
+			return title
+		}
+	case by == git.GroupByTicket && key == "":
+		return i18n.T("group.no_ticket")
+	case by == git.GroupByBranch && key == "":
+		return i18n.T("group.no_branch")
+	case key == "":
+		return i18n.T("group.no_scope")
+	}
+	return key
+}
+
+// totalMerges는 merges: collapse로 뗀 병합 커밋 수이다.
+func totalMerges(results []git.RepoResult) int {
+	n := 0
+	for _, r := range results {
+		n += len(r.Merges)
+	}
+	return n
+}
+
+// totalsText는 하단 통계 문구이다. 따로 센 병합 커밋이 있으면 뒤에 붙인다.
+func totalsText(results []git.RepoResult, commits, files, insertions, deletions int) string {
+	text := i18n.T("report.totals", commits, len(results), files, formatChurn(insertions, deletions))
+	if merges := totalMerges(results); merges > 0 {
+		text += " | " + i18n.T("report.merges", merges)
+	}
+	return text
+}
+
+// sectionChurn은 섹션 커밋의 추가/삭제 라인 수 합계이다.
+func sectionChurn(commits []git.Commit) (insertions, deletions int) {
+	for _, c := range commits {
+		insertions += c.Insertions
+		deletions += c.Deletions
+	}
+	return insertions, deletions
+}
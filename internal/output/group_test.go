@@ -0,0 +1,105 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+func TestToMarkdown_GroupByType(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{
+			{Hash: "a1", Message: "feat(auth): 토큰 갱신", Files: 1, Insertions: 10},
+			{Hash: "a2", Message: "fix: 타임아웃", Files: 1, Insertions: 1, Deletions: 1},
+		}},
+		{Name: "web", Commits: []git.Commit{
+			{Hash: "w1", Message: "chore(deps): 의존성 업데이트"},
+			{Hash: "w2", Message: "feat!: 로그인 화면 교체", Files: 2, Insertions: 5, Deletions: 40},
+		}},
+	}
+
+	md := ToMarkdown(results, period.Range{}, "", Options{GroupBy: git.GroupByType})
+
+	wantOrder := []string{
+		"## ⚠ 호환성이 깨지는 변경 (1 commits, +5 -40)",
+		"- `w2` [web] feat!: 로그인 화면 교체",
+		"## 기능 (1 commits, +10 -0)",
+		"- `a1` [api] auth: 토큰 갱신 (1 files, +10 -0)",
+		"## 버그 수정 (1 commits, +1 -1)",
+		"## 기타 작업 (1 commits, +0 -0)",
+		"- `w1` [web] deps: 의존성 업데이트",
+		"총 4 commits | 2개 프로젝트",
+	}
+	pos := 0
+	for _, want := range wantOrder {
+		i := strings.Index(md[pos:], want)
+		if i < 0 {
+			t.Fatalf("missing (or out of order) %q in:\n%s", want, md)
+		}
+		pos += i + len(want)
+	}
+}
+
+func TestReportSections_SingleRepo(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{{Hash: "a1", Message: "docs(readme): 설치 방법"}}},
+	}
+
+	sections := reportSections(results, git.GroupByScope)
+	if len(sections) != 1 || sections[0].title != "readme" {
+		t.Fatalf("sections = %+v", sections)
+	}
+	// 레포가 하나뿐이면 메시지 앞에 레포 이름을 붙이지 않는다
+	if got := sections[0].commits[0].Message; got != "docs: 설치 방법" {
+		t.Errorf("message = %q, want %q", got, "docs: 설치 방법")
+	}
+	if results[0].Commits[0].Message != "docs(readme): 설치 방법" {
+		t.Error("reportSections must not modify the original commits")
+	}
+}
+
+func TestToMarkdown_CollapsedMerges(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api",
+			Commits: []git.Commit{{Hash: "a2", Message: "로그인 화면", Files: 1, Insertions: 10}},
+			Merges: []git.Commit{
+				{Hash: "a1", Message: "Merge pull request #12 from kso/login", Merge: true},
+				{Hash: "a3", Message: "Merge branch 'main'", Merge: true},
+			},
+		},
+	}
+
+	md := ToMarkdown(results, period.Range{}, "", Options{})
+
+	for _, want := range []string{"⤵ 병합 PR #12, main", "총 1 commits", "병합 2건"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("missing %q in:\n%s", want, md)
+		}
+	}
+	if strings.Contains(md, "`a1`") {
+		t.Errorf("collapsed merge should not be listed as a commit:\n%s", md)
+	}
+}
+
+func TestToMarkdown_CollapsedMergesGrouped(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api",
+			Commits: []git.Commit{{Hash: "a2", Message: "feat: 로그인 화면"}},
+			Merges:  []git.Commit{{Hash: "a1", Message: "Merge pull request #12 from kso/login", Merge: true}},
+		},
+		{Name: "web",
+			Merges: []git.Commit{{Hash: "w1", Message: "Merge branch 'main'", Merge: true}},
+		},
+	}
+
+	md := ToMarkdown(results, period.Range{}, "", Options{GroupBy: git.GroupByType})
+
+	// 하단 통계의 병합 수와 맞도록 묶음 뒤에 병합 섹션을 따로 둔다
+	for _, want := range []string{"## 병합\n", "⤵ 병합 [api] PR #12 · [web] main", "병합 2건"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("missing %q in:\n%s", want, md)
+		}
+	}
+}
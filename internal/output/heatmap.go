@@ -0,0 +1,156 @@
+package output
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/kso1204/gitday/internal/stats"
+)
+
+// heatChars는 단계별 칸 문자이다. 색을 끈 출력에서도 단계를 구분할 수 있게 문자도 바꾼다.
+var heatChars = [stats.HeatmapLevels]string{"·", "░", "▒", "▓", "█"}
+
+// heatColors는 SVG 칸 색이다 (GitHub 잔디 색).
+var heatColors = [stats.HeatmapLevels]string{"#ebedf0", "#9be9a8", "#40c463", "#30a14e", "#216e39"}
+
+// PrintHeatmap은 히트맵을 주(열) × 요일(행) 격자로 출력한다.
+// 터미널이 좁으면 오래된 주부터 잘라 너비에 맞춘다.
+func PrintHeatmap(h stats.Heatmap, rng period.Range, width int) {
+	fmt.Println(outStyles.title.Render("📅 " + PeriodTitle(rng)))
+	fmt.Println()
+
+	labelWidth := 0
+	for d := 0; d < 7; d++ {
+		labelWidth = max(labelWidth, ansi.StringWidth(i18n.Weekday(weekdayOfRow(d))))
+	}
+	labelWidth++
+
+	weeks := h.Weeks()
+	if fit := (width - labelWidth) / 2; fit > 0 && len(weeks) > fit {
+		weeks = weeks[len(weeks)-fit:]
+	}
+
+	// 월 표시: 그 달 1일이 들어 있는 주(또는 첫 주) 위에 쓴다
+	var months strings.Builder
+	months.WriteString(strings.Repeat(" ", labelWidth))
+	col := 0
+	for i, week := range weeks {
+		label := ""
+		for _, day := range week {
+			if day.InRange && (day.Date.Day() == 1 || i == 0) {
+				label = i18n.T(fmt.Sprintf("month.%d", int(day.Date.Month())))
+				break
+			}
+		}
+		if label != "" && i*2 >= col {
+			months.WriteString(strings.Repeat(" ", i*2-col))
+			months.WriteString(label)
+			col = i*2 + ansi.StringWidth(label)
+		}
+	}
+	fmt.Println(outStyles.stat.Render(strings.TrimRight(months.String(), " ")))
+
+	for d := 0; d < 7; d++ {
+		label := ""
+		if d%2 == 0 && d < 6 { // 월/수/금만 표시
+			label = i18n.Weekday(weekdayOfRow(d))
+		}
+		var row strings.Builder
+		row.WriteString(outStyles.stat.Render(padRight(label, labelWidth)))
+		for _, week := range weeks {
+			day := week[d]
+			if !day.InRange {
+				row.WriteString("  ")
+				continue
+			}
+			level := h.Level(day.Commits)
+			row.WriteString(outStyles.heat[level].Render(heatChars[level]) + " ")
+		}
+		fmt.Println(strings.TrimRight(row.String(), " "))
+	}
+	fmt.Println()
+
+	// 범례와 통계
+	legend := make([]string, stats.HeatmapLevels)
+	for i := range legend {
+		legend[i] = outStyles.heat[i].Render(heatChars[i])
+	}
+	fmt.Printf("%s%s %s %s\n", strings.Repeat(" ", labelWidth),
+		outStyles.stat.Render(i18n.T("heatmap.less")), strings.Join(legend, " "), outStyles.stat.Render(i18n.T("heatmap.more")))
+	fmt.Println()
+
+	fmt.Println(outStyles.summaryBar.Render("📊 " + i18n.T("heatmap.totals", h.Total, h.ActiveDays, h.LongestStreak)))
+	if h.Max > 0 {
+		busiest := fmt.Sprintf("%s (%s)", h.BusiestDay.Format("2006-01-02"), i18n.Weekday(h.BusiestDay.Weekday()))
+		fmt.Println(outStyles.summaryBar.Render("🔥 " + i18n.T("heatmap.busiest", busiest, h.Max)))
+	}
+}
+
+// HeatmapSVG는 히트맵을 단독 SVG 문서로 만든다. 칸마다 날짜와 커밋 수를 툴팁(title)으로 단다.
+func HeatmapSVG(h stats.Heatmap, rng period.Range) []byte {
+	const (
+		cell   = 11
+		step   = 13
+		left   = 32
+		top    = 36
+		legend = 24
+	)
+	weeks := h.Weeks()
+	width := left + len(weeks)*step + 8
+	height := top + 7*step + legend + 8
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="-apple-system, 'Segoe UI', sans-serif" font-size="10">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&sb, `<text x="0" y="12" font-size="12" font-weight="600">%s</text>`+"\n",
+		html.EscapeString(PeriodTitle(rng)+" · "+i18n.T("heatmap.totals", h.Total, h.ActiveDays, h.LongestStreak)))
+
+	lastMonth := -1
+	for i, week := range weeks {
+		for _, day := range week {
+			if day.InRange && (day.Date.Day() == 1 || i == 0) && int(day.Date.Month()) != lastMonth {
+				lastMonth = int(day.Date.Month())
+				fmt.Fprintf(&sb, `<text x="%d" y="%d" fill="#656d76">%s</text>`+"\n",
+					left+i*step, top-6, html.EscapeString(i18n.T(fmt.Sprintf("month.%d", lastMonth))))
+				break
+			}
+		}
+	}
+	for d := 0; d < 6; d += 2 {
+		fmt.Fprintf(&sb, `<text x="0" y="%d" fill="#656d76">%s</text>`+"\n",
+			top+d*step+cell-1, html.EscapeString(i18n.Weekday(weekdayOfRow(d))))
+	}
+
+	for i, week := range weeks {
+		for d, day := range week {
+			if !day.InRange {
+				continue
+			}
+			fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"><title>%s: %d commits</title></rect>`+"\n",
+				left+i*step, top+d*step, cell, cell, heatColors[h.Level(day.Commits)], day.Date.Format("2006-01-02"), day.Commits)
+		}
+	}
+
+	y := top + 7*step + 12
+	x := left
+	fmt.Fprintf(&sb, `<text x="%d" y="%d" fill="#656d76">%s</text>`+"\n", x, y+cell-1, html.EscapeString(i18n.T("heatmap.less")))
+	x += 8 * len([]rune(i18n.T("heatmap.less")))
+	for i := 0; i < stats.HeatmapLevels; i++ {
+		fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"></rect>`+"\n", x+i*step, y, cell, cell, heatColors[i])
+	}
+	x += stats.HeatmapLevels*step + 2
+	fmt.Fprintf(&sb, `<text x="%d" y="%d" fill="#656d76">%s</text>`+"\n", x, y+cell-1, html.EscapeString(i18n.T("heatmap.more")))
+
+	sb.WriteString("</svg>\n")
+	return []byte(sb.String())
+}
+
+// weekdayOfRow는 히트맵 행 번호(0 = 월요일)의 요일이다.
+func weekdayOfRow(row int) time.Weekday {
+	return time.Weekday((row + 1) % 7)
+}
@@ -0,0 +1,55 @@
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/kso1204/gitday/internal/stats"
+)
+
+func TestHeatmapSVG(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	rng := period.Range{
+		Since: time.Date(2026, 9, 28, 0, 0, 0, 0, kst),
+		Until: time.Date(2026, 10, 11, 0, 0, 0, 0, kst),
+	}
+	results := []git.RepoResult{{Name: "rpg", Commits: []git.Commit{
+		{Date: time.Date(2026, 10, 1, 10, 0, 0, 0, kst)},
+		{Date: time.Date(2026, 10, 1, 11, 0, 0, 0, kst)},
+	}}}
+
+	doc := HeatmapSVG(stats.NewHeatmap(results, rng), rng)
+
+	// 올바른 XML이어야 한다
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+	rects := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("invalid SVG: %v\n%s", err, doc)
+		}
+		if el, ok := tok.(xml.StartElement); ok && el.Name.Local == "rect" {
+			rects++
+		}
+	}
+
+	// 09-28(월) ~ 10-10(토) 13칸 + 범례 5칸. 기간 밖의 10-11(일)은 그리지 않는다
+	if rects != 13+stats.HeatmapLevels {
+		t.Errorf("rects = %d, want %d", rects, 13+stats.HeatmapLevels)
+	}
+	if !strings.Contains(string(doc), "2026-10-01: 2 commits") {
+		t.Error("cell title should include date and commit count")
+	}
+	if !strings.Contains(string(doc), heatColors[stats.HeatmapLevels-1]) {
+		t.Error("busiest day should use the darkest color")
+	}
+}
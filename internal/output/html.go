@@ -0,0 +1,261 @@
+package output
+
+import (
+	"bytes"
+	"html/template"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// HTML 차트 크기 (px)
+const (
+	chartLabelWidth = 160
+	chartBarWidth   = 420
+	chartRowHeight  = 24
+)
+
+type htmlData struct {
+	Title     string
+	Generated string
+	Commits   int
+	Files     int
+	Churn     string
+	Repos     []htmlRepo
+	Chart     htmlChart
+	Hours     []htmlHour
+	Summary   string
+	ShowFiles bool
+	Activity  *activityText // --stats일 때만
+}
+
+type htmlRepo struct {
+	Title   string
+	Path    string
+	Stat    string
+	Commits []htmlCommit
+}
+
+type htmlCommit struct {
+	Hash    string
+	Message string
+	Time    string
+	Stat    string
+	Changes []htmlChange
+}
+
+type htmlChange struct {
+	Path string
+	Stat string
+}
+
+type htmlChart struct {
+	Width  int
+	Height int
+	Bars   []htmlBar
+}
+
+type htmlBar struct {
+	Label  string
+	Count  int
+	Y      int
+	TextY  int
+	X      int
+	Width  int
+	ValueX int
+}
+
+type htmlHour struct {
+	Hour    int
+	Count   int
+	Opacity float64
+}
+
+// ToHTML은 리포트를 외부 리소스 없이 열리는 단일 HTML 문서로 변환한다.
+// 레포별 커밋 목록(접기/펼치기), 레포별 커밋 수 막대 차트, 시간대별 활동 띠, AI 요약을 담는다.
+func ToHTML(results []git.RepoResult, rng period.Range, summary string, opts Options) ([]byte, error) {
+	data := htmlData{
+		Title:     PeriodTitle(rng),
+		Generated: time.Now().In(rng.Until.Location()).Format("2006-01-02 15:04"),
+		Summary:   summary,
+		ShowFiles: opts.ShowFiles,
+	}
+
+	var hours [24]int
+	totalIns, totalDel := 0, 0
+	maxCommits := 0
+
+	for _, r := range results {
+		data.Commits += len(r.Commits)
+		data.Files += r.TotalFiles()
+		totalIns += r.TotalInsertions()
+		totalDel += r.TotalDeletions()
+		if len(r.Commits) > maxCommits {
+			maxCommits = len(r.Commits)
+		}
+
+		repo := htmlRepo{
+			Title: repoTitle(r),
+			Path:  r.Path,
+			Stat:  formatChurn(r.TotalInsertions(), r.TotalDeletions()),
+		}
+		for _, c := range r.Commits {
+			local := c.Date.In(rng.Since.Location())
+			hours[local.Hour()]++
+
+			hc := htmlCommit{Hash: c.Hash, Message: c.Message, Time: local.Format("01-02 15:04")}
+			if c.Files > 0 {
+				hc.Stat = formatCommitStat(c)
+			}
+			for _, fc := range c.Changes {
+				hc.Changes = append(hc.Changes, htmlChange{Path: fc.Path, Stat: formatFileChurn(fc)})
+			}
+			repo.Commits = append(repo.Commits, hc)
+		}
+		data.Repos = append(data.Repos, repo)
+	}
+	data.Churn = formatChurn(totalIns, totalDel)
+	if opts.Stats {
+		activity := newActivityText(results, rng)
+		data.Activity = &activity
+	}
+
+	// 레포별 커밋 수 막대 차트
+	data.Chart = htmlChart{Width: chartLabelWidth + chartBarWidth + 40, Height: len(results) * chartRowHeight}
+	for i, r := range results {
+		width := 0
+		if maxCommits > 0 {
+			width = len(r.Commits) * chartBarWidth / maxCommits
+		}
+		y := i * chartRowHeight
+		data.Chart.Bars = append(data.Chart.Bars, htmlBar{
+			Label:  r.Name,
+			Count:  len(r.Commits),
+			Y:      y + 4,
+			TextY:  y + chartRowHeight/2 + 5,
+			X:      chartLabelWidth,
+			Width:  width,
+			ValueX: chartLabelWidth + width + 6,
+		})
+	}
+
+	// 시간대별 활동 띠 (가장 바쁜 시간 = 불투명)
+	maxHour := 0
+	for _, n := range hours {
+		if n > maxHour {
+			maxHour = n
+		}
+	}
+	for h, n := range hours {
+		opacity := 0.0
+		if maxHour > 0 && n > 0 {
+			opacity = 0.15 + 0.85*float64(n)/float64(maxHour)
+		}
+		data.Hours = append(data.Hours, htmlHour{Hour: h, Count: n, Opacity: opacity})
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"t":    i18n.T,
+	"lang": i18n.Language,
+}).Parse(`<!DOCTYPE html>
+<html lang="{{lang}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>gitday · {{.Title}}</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", "Apple SD Gothic Neo", "Malgun Gothic", sans-serif; margin: 2rem auto; max-width: 960px; padding: 0 1rem; color: #1f2328; }
+  h1 { font-size: 1.6rem; margin-bottom: .2rem; }
+  h2 { font-size: 1.1rem; margin-top: 2rem; border-bottom: 1px solid #d0d7de; padding-bottom: .3rem; }
+  .meta { color: #656d76; font-size: .9rem; }
+  .totals { display: flex; gap: 1.5rem; margin: 1rem 0; }
+  .totals div { background: #f6f8fa; border-radius: 6px; padding: .6rem 1rem; }
+  .totals b { display: block; font-size: 1.3rem; }
+  details { border: 1px solid #d0d7de; border-radius: 6px; margin: .5rem 0; padding: .4rem .8rem; }
+  summary { cursor: pointer; font-weight: 600; }
+  summary .stat, .commit .stat, .time { color: #656d76; font-weight: normal; font-size: .85rem; }
+  .path { color: #656d76; font-size: .8rem; margin: .2rem 0 .4rem; }
+  ul { list-style: none; padding-left: .5rem; margin: .3rem 0; }
+  .commit { padding: .15rem 0; }
+  code { background: #f6f8fa; border-radius: 4px; padding: 0 .3rem; color: #953800; }
+  ul.files { padding-left: 2rem; font-size: .85rem; }
+  .hours { display: grid; grid-template-columns: repeat(24, 1fr); gap: 2px; }
+  .hour { height: 28px; border-radius: 3px; background: #ebedf0; position: relative; }
+  .hour span { position: absolute; inset: 0; border-radius: 3px; background: #216e39; }
+  .hour-labels { display: grid; grid-template-columns: repeat(24, 1fr); font-size: .7rem; color: #656d76; text-align: center; }
+  .summary { white-space: pre-wrap; background: #f6f8fa; border-radius: 6px; padding: 1rem; line-height: 1.6; }
+  svg text { font-size: 12px; fill: #1f2328; }
+</style>
+</head>
+<body>
+<h1>📅 {{.Title}}</h1>
+<p class="meta">gitday · {{t "html.generated" .Generated}}</p>
+
+<div class="totals">
+  <div><b>{{.Commits}}</b>commits</div>
+  <div><b>{{len .Repos}}</b>{{t "html.projects"}}</div>
+  <div><b>{{.Files}}</b>files changed</div>
+  <div><b>{{.Churn}}</b>lines</div>
+</div>
+
+{{if .Summary}}
+<h2>{{t "report.summary_md"}}</h2>
+<div class="summary">{{.Summary}}</div>
+{{end}}
+
+<h2>{{t "html.by_repo"}}</h2>
+<svg width="{{.Chart.Width}}" height="{{.Chart.Height}}" role="img" aria-label="{{t "html.by_repo_a"}}">
+{{- range .Chart.Bars}}
+  <text x="0" y="{{.TextY}}">{{.Label}}</text>
+  <rect x="{{.X}}" y="{{.Y}}" width="{{.Width}}" height="16" rx="3" fill="#0969da"></rect>
+  <text x="{{.ValueX}}" y="{{.TextY}}">{{.Count}}</text>
+{{- end}}
+</svg>
+
+<h2>{{t "html.by_hour"}}</h2>
+<div class="hours">
+{{- range .Hours}}
+  <div class="hour" title="{{t "html.hour" .Hour}}: {{.Count}} commits"><span style="opacity: {{.Opacity}}"></span></div>
+{{- end}}
+</div>
+<div class="hour-labels">{{range .Hours}}<div>{{.Hour}}</div>{{end}}</div>
+
+{{with .Activity}}
+<h2>{{t "stats.title"}}</h2>
+<ul>{{range .Info}}<li>{{.}}</li>{{end}}</ul>
+{{if .Weekdays}}<pre>{{range .Weekdays}}{{.}}
+{{end}}</pre>{{end}}
+{{end}}
+
+<h2>{{t "html.commits"}}</h2>
+{{- range .Repos}}
+<details open>
+  <summary>{{.Title}} <span class="stat">({{len .Commits}} commits, {{.Stat}})</span></summary>
+  <div class="path">{{.Path}}</div>
+  <ul>
+  {{- range .Commits}}
+    <li class="commit"><code>{{.Hash}}</code> {{.Message}} <span class="time">{{.Time}}</span>{{if .Stat}} <span class="stat">({{.Stat}})</span>{{end}}
+    {{- if and $.ShowFiles .Changes}}
+      <ul class="files">
+      {{- range .Changes}}
+        <li>{{.Path}} <span class="stat">{{.Stat}}</span></li>
+      {{- end}}
+      </ul>
+    {{- end}}
+    </li>
+  {{- end}}
+  </ul>
+</details>
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,36 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToHTML(t *testing.T) {
+	results, rng := jsonFixture()
+
+	data, err := ToHTML(results, rng, "오늘은 <전투> 작업", Options{ShowFiles: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := string(data)
+
+	for _, want := range []string{
+		"<details open>",         // 레포별 접기/펼치기
+		"<svg",                   // 막대 차트
+		`title="15시: 1 commits"`, // 시간대 띠 (커밋 시각은 기간 타임존 기준)
+		"전투 &lt;버그&gt; 수정",       // 메시지는 이스케이프
+		"오늘은 &lt;전투&gt; 작업",      // AI 요약 포함
+		"battle.go",              // --files
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("HTML should contain %q", want)
+		}
+	}
+
+	// 외부 리소스 없이 열려야 한다
+	for _, external := range []string{"<script src", "<link", "http://", "https://"} {
+		if strings.Contains(html, external) {
+			t.Errorf("HTML should be self-contained, found %q", external)
+		}
+	}
+}
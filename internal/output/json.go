@@ -0,0 +1,276 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+	"github.com/kso1204/gitday/internal/stats"
+)
+
+// SchemaVersion은 JSON/NDJSON 출력 스키마 버전이다.
+// 필드를 지우거나 의미를 바꾸면 올리고, 필드 추가는 같은 버전에서 한다.
+const SchemaVersion = "gitday.report/v1"
+
+// NDJSON 레코드 종류
+const (
+	recordReport   = "report"   // 첫 줄: 기간, 전체 통계
+	recordRepo     = "repo"     // 레포마다 한 줄
+	recordActivity = "activity" // 활동 통계 (--stats일 때만)
+	recordSummary  = "summary"  // 마지막 줄: AI 요약 (있을 때만)
+)
+
+type jsonReport struct {
+	Schema   string           `json:"schema"`
+	Period   jsonPeriod       `json:"period"`
+	Totals   jsonReportTotals `json:"totals"`
+	Repos    []jsonRepo       `json:"repos"`
+	Activity *jsonActivity    `json:"activity,omitempty"`
+	Summary  string           `json:"summary,omitempty"`
+}
+
+type jsonPeriod struct {
+	Name  string `json:"name"`
+	Since string `json:"since"`
+	Until string `json:"until"`
+}
+
+type jsonReportTotals struct {
+	Repos int `json:"repos"`
+	jsonTotals
+}
+
+type jsonTotals struct {
+	Commits    int `json:"commits"`
+	Files      int `json:"files"`
+	Insertions int `json:"insertions"`
+	Deletions  int `json:"deletions"`
+	Merges     int `json:"merges,omitempty"` // merges: collapse로 따로 센 병합 커밋
+}
+
+type jsonRepo struct {
+	Name      string         `json:"name"`
+	Path      string         `json:"path"`
+	Kind      string         `json:"kind,omitempty"`
+	Branch    string         `json:"branch,omitempty"`
+	Worktrees []jsonWorktree `json:"worktrees,omitempty"`
+	Totals    jsonTotals     `json:"totals"`
+	Commits   []jsonCommit   `json:"commits"`
+	Merges    []jsonMerge    `json:"merges,omitempty"`
+}
+
+// jsonMerge는 merges: collapse로 커밋 목록에서 뗀 병합 커밋이다.
+type jsonMerge struct {
+	Hash    string `json:"hash"`
+	Label   string `json:"label"` // "PR #12", "feature/login"
+	Message string `json:"message"`
+	Author  string `json:"author"`
+	Date    string `json:"date"`
+}
+
+type jsonWorktree struct {
+	Path   string `json:"path"`
+	Branch string `json:"branch"`
+}
+
+type jsonCommit struct {
+	Hash       string           `json:"hash"`
+	Message    string           `json:"message"`
+	Author     string           `json:"author"`
+	Email      string           `json:"email,omitempty"`
+	CoAuthors  []string         `json:"co_authors,omitempty"`
+	Date       string           `json:"date"`
+	Files      int              `json:"files"`
+	Insertions int              `json:"insertions"`
+	Deletions  int              `json:"deletions"`
+	Merge      bool             `json:"merge,omitempty"`
+	Tickets    []string         `json:"tickets,omitempty"`
+	Branch     string           `json:"branch,omitempty"`
+	Branches   []string         `json:"branches,omitempty"`
+	Changes    []jsonFileChange `json:"changes,omitempty"`
+}
+
+// jsonActivity는 --stats의 활동 통계이다. 시각은 RFC 3339, 요일 키는 mon~sun이다.
+type jsonActivity struct {
+	ByHour      [24]int        `json:"by_hour"`
+	ByWeekday   map[string]int `json:"by_weekday"`
+	FirstCommit string         `json:"first_commit,omitempty"`
+	LastCommit  string         `json:"last_commit,omitempty"`
+	LongestGap  *jsonGap       `json:"longest_gap,omitempty"`
+}
+
+type jsonGap struct {
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	Minutes int    `json:"minutes"`
+}
+
+type jsonFileChange struct {
+	Path       string `json:"path"`
+	Insertions int    `json:"insertions"`
+	Deletions  int    `json:"deletions"`
+	Binary     bool   `json:"binary,omitempty"`
+}
+
+// ndjsonRecord는 NDJSON 한 줄이다. 모든 줄이 schema와 type을 가져 줄 단위로 처리할 수 있다.
+type ndjsonRecord struct {
+	Schema   string            `json:"schema"`
+	Type     string            `json:"type"`
+	Period   *jsonPeriod       `json:"period,omitempty"`
+	Totals   *jsonReportTotals `json:"totals,omitempty"`
+	Repo     *jsonRepo         `json:"repo,omitempty"`
+	Activity *jsonActivity     `json:"activity,omitempty"`
+	Summary  string            `json:"summary,omitempty"`
+}
+
+// ToJSON은 리포트 전체를 하나의 JSON 문서로 변환한다.
+// opts.Stats이면 activity(활동 통계)를 포함한다.
+func ToJSON(results []git.RepoResult, rng period.Range, summary string, opts Options) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(newJSONReport(results, rng, summary, opts)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ToNDJSON은 리포트를 줄 단위 JSON으로 변환한다.
+// 첫 줄은 report(기간/전체 통계), 이어서 레포마다 repo, --stats면 activity, AI 요약이 있으면 마지막에 summary이다.
+func ToNDJSON(results []git.RepoResult, rng period.Range, summary string, opts Options) ([]byte, error) {
+	report := newJSONReport(results, rng, summary, opts)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	records := []ndjsonRecord{{Type: recordReport, Period: &report.Period, Totals: &report.Totals}}
+	for i := range report.Repos {
+		records = append(records, ndjsonRecord{Type: recordRepo, Repo: &report.Repos[i]})
+	}
+	if report.Activity != nil {
+		records = append(records, ndjsonRecord{Type: recordActivity, Activity: report.Activity})
+	}
+	if summary != "" {
+		records = append(records, ndjsonRecord{Type: recordSummary, Summary: summary})
+	}
+
+	for _, rec := range records {
+		rec.Schema = SchemaVersion
+		if err := enc.Encode(rec); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func newJSONReport(results []git.RepoResult, rng period.Range, summary string, opts Options) jsonReport {
+	report := jsonReport{
+		Schema: SchemaVersion,
+		Period: jsonPeriod{
+			Name:  rng.Name,
+			Since: rng.Since.Format(time.RFC3339),
+			Until: rng.Until.Format(time.RFC3339),
+		},
+		Repos:   make([]jsonRepo, 0, len(results)),
+		Summary: summary,
+	}
+
+	for _, r := range results {
+		repo := jsonRepo{
+			Name:    r.Name,
+			Path:    r.Path,
+			Kind:    string(r.Kind),
+			Branch:  r.Branch,
+			Commits: make([]jsonCommit, 0, len(r.Commits)),
+			Totals: jsonTotals{
+				Commits:    len(r.Commits),
+				Files:      r.TotalFiles(),
+				Insertions: r.TotalInsertions(),
+				Deletions:  r.TotalDeletions(),
+				Merges:     len(r.Merges),
+			},
+		}
+		for _, wt := range r.Worktrees {
+			repo.Worktrees = append(repo.Worktrees, jsonWorktree{Path: wt.Path, Branch: wt.Branch})
+		}
+		for _, c := range r.Commits {
+			repo.Commits = append(repo.Commits, newJSONCommit(c))
+		}
+		for _, m := range r.Merges {
+			repo.Merges = append(repo.Merges, jsonMerge{
+				Hash:    m.Hash,
+				Label:   m.MergeLabel(),
+				Message: m.Message,
+				Author:  m.Author,
+				Date:    m.Date.Format(time.RFC3339),
+			})
+		}
+
+		report.Repos = append(report.Repos, repo)
+		report.Totals.Repos++
+		report.Totals.Commits += repo.Totals.Commits
+		report.Totals.Files += repo.Totals.Files
+		report.Totals.Insertions += repo.Totals.Insertions
+		report.Totals.Deletions += repo.Totals.Deletions
+		report.Totals.Merges += repo.Totals.Merges
+	}
+
+	if opts.Stats {
+		report.Activity = newJSONActivity(stats.NewActivity(results, rng.Calendar()))
+	}
+	return report
+}
+
+func newJSONActivity(a stats.Activity) *jsonActivity {
+	ja := &jsonActivity{ByHour: a.ByHour, ByWeekday: make(map[string]int, 7)}
+	for w, n := range a.ByWeekday {
+		ja.ByWeekday[weekdayKeys[w]] = n
+	}
+	if a.Commits > 0 {
+		ja.FirstCommit = a.First.Format(time.RFC3339)
+		ja.LastCommit = a.Last.Format(time.RFC3339)
+	}
+	if gap := a.LongestGap; gap.Duration() > 0 {
+		ja.LongestGap = &jsonGap{
+			Start:   gap.Start.Format(time.RFC3339),
+			End:     gap.End.Format(time.RFC3339),
+			Minutes: int(gap.Duration() / time.Minute),
+		}
+	}
+	return ja
+}
+
+// weekdayKeys는 JSON 요일 키이다 (time.Weekday 순서).
+var weekdayKeys = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+func newJSONCommit(c git.Commit) jsonCommit {
+	jc := jsonCommit{
+		Hash:       c.Hash,
+		Message:    c.Message,
+		Author:     c.Author,
+		Email:      c.Email,
+		CoAuthors:  c.CoAuthors,
+		Date:       c.Date.Format(time.RFC3339),
+		Files:      c.Files,
+		Insertions: c.Insertions,
+		Deletions:  c.Deletions,
+		Merge:      c.Merge,
+		Tickets:    c.Tickets,
+		Branch:     c.Branch,
+		Branches:   c.Branches,
+	}
+	for _, fc := range c.Changes {
+		jc.Changes = append(jc.Changes, jsonFileChange{
+			Path:       fc.Path,
+			Insertions: fc.Insertions,
+			Deletions:  fc.Deletions,
+			Binary:     fc.Binary,
+		})
+	}
+	return jc
+}
@@ -0,0 +1,179 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+func jsonFixture() ([]git.RepoResult, period.Range) {
+	kst := time.FixedZone("KST", 9*60*60)
+	results := []git.RepoResult{
+		{
+			Name: "rpg",
+			Path: "/work/rpg",
+			Kind: git.KindNormal,
+			Commits: []git.Commit{
+				{
+					Hash: "dbc7067", Message: "전투 <버그> 수정", Author: "wook",
+					Date:  time.Date(2026, 2, 26, 15, 0, 0, 0, kst),
+					Files: 2, Insertions: 30, Deletions: 4,
+					Changes: []git.FileChange{{Path: "battle.go", Insertions: 30, Deletions: 4}, {Path: "logo.png", Binary: true}},
+				},
+			},
+		},
+	}
+	rng := period.Range{
+		Name:  period.Today,
+		Since: time.Date(2026, 2, 26, 0, 0, 0, 0, kst),
+		Until: time.Date(2026, 2, 26, 18, 0, 0, 0, kst),
+	}
+	return results, rng
+}
+
+func TestToJSON(t *testing.T) {
+	results, rng := jsonFixture()
+
+	data, err := ToJSON(results, rng, "요약", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Schema string `json:"schema"`
+		Period struct {
+			Since string `json:"since"`
+		} `json:"period"`
+		Totals struct {
+			Repos      int `json:"repos"`
+			Commits    int `json:"commits"`
+			Insertions int `json:"insertions"`
+		} `json:"totals"`
+		Repos []struct {
+			Path    string `json:"path"`
+			Commits []struct {
+				Message string `json:"message"`
+				Date    string `json:"date"`
+				Changes []struct {
+					Path   string `json:"path"`
+					Binary bool   `json:"binary"`
+				} `json:"changes"`
+			} `json:"commits"`
+		} `json:"repos"`
+		Summary string `json:"summary"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, data)
+	}
+
+	if doc.Schema != SchemaVersion {
+		t.Errorf("schema = %q, want %q", doc.Schema, SchemaVersion)
+	}
+	if doc.Period.Since != "2026-02-26T00:00:00+09:00" {
+		t.Errorf("since = %q, want RFC 3339", doc.Period.Since)
+	}
+	if doc.Totals.Repos != 1 || doc.Totals.Commits != 1 || doc.Totals.Insertions != 30 {
+		t.Errorf("totals = %+v", doc.Totals)
+	}
+	c := doc.Repos[0].Commits[0]
+	if c.Message != "전투 <버그> 수정" || c.Date != "2026-02-26T15:00:00+09:00" {
+		t.Errorf("commit = %+v", c)
+	}
+	if len(c.Changes) != 2 || !c.Changes[1].Binary {
+		t.Errorf("changes = %+v", c.Changes)
+	}
+	if doc.Summary != "요약" {
+		t.Errorf("summary = %q", doc.Summary)
+	}
+	if !bytes.Contains(data, []byte(`<버그>`)) {
+		t.Error("HTML characters should not be escaped")
+	}
+}
+
+func TestToJSON_Empty(t *testing.T) {
+	_, rng := jsonFixture()
+	data, err := ToJSON(nil, rng, "", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`"repos": []`)) {
+		t.Errorf("empty report should have an empty repos array:\n%s", data)
+	}
+}
+
+func TestToNDJSON(t *testing.T) {
+	results, rng := jsonFixture()
+
+	data, err := ToNDJSON(results, rng, "요약", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var types []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var rec struct {
+			Schema string `json:"schema"`
+			Type   string `json:"type"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("invalid NDJSON line: %v\n%s", err, scanner.Text())
+		}
+		if rec.Schema != SchemaVersion {
+			t.Errorf("line schema = %q", rec.Schema)
+		}
+		types = append(types, rec.Type)
+	}
+
+	want := []string{"report", "repo", "summary"}
+	if len(types) != len(want) {
+		t.Fatalf("record types = %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Errorf("record types = %v, want %v", types, want)
+		}
+	}
+}
+
+func TestToJSON_Stats(t *testing.T) {
+	results, rng := jsonFixture()
+
+	data, err := ToJSON(results, rng, "", Options{Stats: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Activity *struct {
+			ByHour      []int          `json:"by_hour"`
+			ByWeekday   map[string]int `json:"by_weekday"`
+			FirstCommit string         `json:"first_commit"`
+		} `json:"activity"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Activity == nil {
+		t.Fatalf("activity missing:\n%s", data)
+	}
+	if len(doc.Activity.ByHour) != 24 || doc.Activity.ByHour[15] != 1 {
+		t.Errorf("by_hour = %v", doc.Activity.ByHour)
+	}
+	if doc.Activity.ByWeekday["thu"] != 1 || len(doc.Activity.ByWeekday) != 7 {
+		t.Errorf("by_weekday = %v", doc.Activity.ByWeekday)
+	}
+	if doc.Activity.FirstCommit != "2026-02-26T15:00:00+09:00" {
+		t.Errorf("first_commit = %q", doc.Activity.FirstCommit)
+	}
+
+	plain, _ := ToJSON(results, rng, "", Options{})
+	if bytes.Contains(plain, []byte(`"activity"`)) {
+		t.Error("activity should be omitted without --stats")
+	}
+}
@@ -0,0 +1,131 @@
+package output
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/term"
+	"github.com/kso1204/gitday/internal/git"
+)
+
+// 긴 커밋 메시지 처리 방식
+const (
+	OverflowWrap     = "wrap"     // 메시지 열 너비에서 줄바꿈 (기본)
+	OverflowTruncate = "truncate" // 한 줄로 자르고 "…"를 붙인다
+)
+
+const (
+	// DefaultWidth는 터미널 너비를 알 수 없을 때(파이프, 파일, cron) 쓰는 너비이다.
+	DefaultWidth = 80
+	// maxHeaderWidth는 레포 헤더 구분선의 최대 너비이다. 넓은 터미널에서 선이 너무 길어지지 않게 한다.
+	maxHeaderWidth = 72
+	// minMessageWidth보다 메시지 열이 좁아지면 변경 통계를 메시지 다음 줄로 내린다.
+	minMessageWidth = 20
+)
+
+// TerminalWidth는 stdout 터미널의 너비를 반환한다.
+// 터미널이 아니면 COLUMNS 환경변수를, 그것도 없으면 DefaultWidth를 쓴다.
+func TerminalWidth() int {
+	if w, _, err := term.GetSize(os.Stdout.Fd()); err == nil && w > 0 {
+		return w
+	}
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	return DefaultWidth
+}
+
+// reportWidth는 옵션에 지정된 너비, 없으면 터미널 너비를 반환한다.
+func reportWidth(opts Options) int {
+	if opts.Width > 0 {
+		return opts.Width
+	}
+	return TerminalWidth()
+}
+
+// repoHeader는 "━━ rpg (3 commits, +12 -3) ━━━━" 형태의 레포 헤더를 만든다.
+// 너비는 표시 폭(한글/이모지 2칸) 기준이며, 좁은 터미널에서는 제목을 잘라 한 줄에 맞춘다.
+func repoHeader(title string, width int) string {
+	lineWidth := min(width, maxHeaderWidth)
+	text := "━━ " + title + " "
+	if ansi.StringWidth(text)+3 > width {
+		text = ansi.Truncate(text, max(width-4, 1), "…") + " "
+	}
+	fill := max(lineWidth-ansi.StringWidth(text), 3)
+	return text + strings.Repeat("━", fill)
+}
+
+// commitLayout은 레포 하나의 커밋 목록을 해시/메시지/변경 통계 열로 맞춰 배치한다.
+//
+//	dbc7067 fix battle bug             (2 files, +30 -4)
+//	a1b2c3d a long message wraps at    (1 files, +1 -0)
+//	        the message column
+type commitLayout struct {
+	hashWidth    int
+	messageWidth int
+	statWidth    int
+	statInline   bool // false면 변경 통계를 메시지 아래 줄에 쓴다
+	overflow     string
+}
+
+// commitIndent는 커밋 줄 앞의 들여쓰기이다.
+const commitIndent = "  "
+
+func newCommitLayout(commits []git.Commit, width int, overflow string) commitLayout {
+	l := commitLayout{overflow: overflow}
+	for _, c := range commits {
+		l.hashWidth = max(l.hashWidth, ansi.StringWidth(c.Hash))
+		l.statWidth = max(l.statWidth, ansi.StringWidth(commitStatText(c)))
+	}
+
+	column := len(commitIndent) + l.hashWidth + 1
+	l.messageWidth = width - column
+	l.statInline = true
+	if l.statWidth > 0 {
+		l.messageWidth -= 2 + l.statWidth
+	}
+	if l.messageWidth < minMessageWidth {
+		l.statInline = false
+		l.messageWidth = max(width-column, minMessageWidth)
+	}
+	return l
+}
+
+// messageColumn은 메시지가 시작하는 열(표시 폭)이다. 줄바꿈된 줄과 파일 목록을 여기에 맞춘다.
+func (l commitLayout) messageColumn() int {
+	return len(commitIndent) + l.hashWidth + 1
+}
+
+// messageLines는 커밋 메시지를 메시지 열 너비에 맞춰 줄 단위로 나눈다.
+func (l commitLayout) messageLines(msg string) []string {
+	if ansi.StringWidth(msg) <= l.messageWidth {
+		return []string{msg}
+	}
+	if l.overflow == OverflowTruncate {
+		return []string{ansi.Truncate(msg, l.messageWidth, "…")}
+	}
+	return strings.Split(ansi.Wrap(msg, l.messageWidth, ""), "\n")
+}
+
+// commitStatText는 커밋 줄 끝의 "(3 files, +12 -3)"이다. 변경 통계가 없으면 빈 문자열이다.
+func commitStatText(c git.Commit) string {
+	if c.Files == 0 {
+		return ""
+	}
+	return "(" + formatCommitStat(c) + ")"
+}
+
+// padRight는 s를 표시 폭 width까지 공백으로 채운다.
+func padRight(s string, width int) string {
+	if w := ansi.StringWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+// wrapText는 여러 줄 텍스트(AI 요약 등)를 너비에 맞춰 줄바꿈한다.
+func wrapText(text string, width int) string {
+	return ansi.Wrap(text, width, "")
+}
@@ -0,0 +1,74 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/kso1204/gitday/internal/git"
+)
+
+func TestRepoHeaderWidth(t *testing.T) {
+	cases := []struct {
+		title string
+		width int
+		want  int
+	}{
+		{"rpg (3 commits, +12 -3)", 120, maxHeaderWidth},
+		{"게임서버 🎮 (3 commits, +12 -3)", 60, 60},
+		{"게임서버 🎮 [worktrees: main, feature/login] (3 commits, +12 -3)", 30, 30},
+	}
+	for _, c := range cases {
+		got := repoHeader(c.title, c.width)
+		if w := ansi.StringWidth(got); w != c.want {
+			t.Errorf("repoHeader(%q, %d) width = %d, want %d: %q", c.title, c.width, w, c.want, got)
+		}
+	}
+}
+
+func TestCommitLayout(t *testing.T) {
+	commits := []git.Commit{
+		{Hash: "dbc7067", Message: "짧은 메시지", Files: 2, Insertions: 30, Deletions: 4},
+		{Hash: "a1b2c3d", Message: "아주 긴 커밋 메시지가 메시지 열 너비를 넘으면 줄바꿈되어야 한다"},
+	}
+
+	l := newCommitLayout(commits, 60, OverflowWrap)
+	if !l.statInline {
+		t.Fatal("stats should fit inline at width 60")
+	}
+	// "  " + 7자 해시 + " " + 메시지 + "  " + "(2 files, +30 -4)"
+	if want := 60 - 10 - 2 - len("(2 files, +30 -4)"); l.messageWidth != want {
+		t.Errorf("messageWidth = %d, want %d", l.messageWidth, want)
+	}
+
+	lines := l.messageLines(commits[1].Message)
+	if len(lines) < 2 {
+		t.Fatalf("long message should wrap: %q", lines)
+	}
+	for _, line := range lines {
+		if ansi.StringWidth(line) > l.messageWidth {
+			t.Errorf("wrapped line %q wider than %d", line, l.messageWidth)
+		}
+	}
+
+	l = newCommitLayout(commits, 60, OverflowTruncate)
+	lines = l.messageLines(commits[1].Message)
+	if len(lines) != 1 || !strings.HasSuffix(lines[0], "…") || ansi.StringWidth(lines[0]) > l.messageWidth {
+		t.Errorf("truncated = %q", lines)
+	}
+
+	// 좁은 창에서는 변경 통계를 다음 줄로 내린다
+	l = newCommitLayout(commits, 36, OverflowWrap)
+	if l.statInline {
+		t.Error("stats should move to their own line at width 36")
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	if got := padRight("한글", 6); got != "한글  " {
+		t.Errorf("padRight = %q", got)
+	}
+	if got := padRight("abcdef", 3); got != "abcdef" {
+		t.Errorf("padRight should not cut: %q", got)
+	}
+}
@@ -3,47 +3,58 @@ package output
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
 )
 
 // ToMarkdown은 리포트를 마크다운 문자열로 변환한다.
-func ToMarkdown(results []git.RepoResult, since, until time.Time, summary string) string {
+func ToMarkdown(results []git.RepoResult, rng period.Range, summary string, opts Options) string {
 	var sb strings.Builder
 
-	weekday := weekdayKo(since.Weekday())
-	sb.WriteString(fmt.Sprintf("# 📅 %s (%s)\n\n", since.Format("2006-01-02"), weekday))
+	sb.WriteString(fmt.Sprintf("# 📅 %s\n\n", PeriodTitle(rng)))
 
 	totalCommits := 0
 	totalFiles := 0
+	totalIns, totalDel := 0, 0
 
 	for _, r := range results {
-		commitCount := len(r.Commits)
-		totalCommits += commitCount
-
-		fileCount := 0
-		for _, c := range r.Commits {
-			fileCount += c.Files
-		}
-		totalFiles += fileCount
+		totalCommits += len(r.Commits)
+		totalFiles += r.TotalFiles()
+		totalIns += r.TotalInsertions()
+		totalDel += r.TotalDeletions()
+	}
 
-		sb.WriteString(fmt.Sprintf("## %s (%d commits)\n\n", r.Name, commitCount))
-		for _, c := range r.Commits {
+	for _, sec := range reportSections(results, opts.GroupBy) {
+		sb.WriteString(fmt.Sprintf("## %s\n\n", sec.heading()))
+		for _, c := range sec.commits {
 			if c.Files > 0 {
-				sb.WriteString(fmt.Sprintf("- `%s` %s (%d files)\n", c.Hash, c.Message, c.Files))
+				sb.WriteString(fmt.Sprintf("- `%s` %s (%s)\n", c.Hash, c.Message, formatCommitStat(c)))
 			} else {
 				sb.WriteString(fmt.Sprintf("- `%s` %s\n", c.Hash, c.Message))
 			}
+			if opts.ShowFiles {
+				for _, fc := range c.Changes {
+					sb.WriteString(fmt.Sprintf("  - `%s` %s\n", fc.Path, formatFileChurn(fc)))
+				}
+			}
+		}
+		if sec.merges != "" {
+			sb.WriteString(fmt.Sprintf("- _%s_\n", i18n.T("report.merged", sec.merges)))
 		}
 		sb.WriteString("\n")
 	}
 
-	sb.WriteString(fmt.Sprintf("---\n\n📊 **총 %d commits | %d개 프로젝트 | %d files changed**\n",
-		totalCommits, len(results), totalFiles))
+	sb.WriteString(fmt.Sprintf("---\n\n📊 **%s**\n",
+		totalsText(results, totalCommits, totalFiles, totalIns, totalDel)))
+
+	if opts.Stats {
+		sb.WriteString(activityMarkdown(results, rng))
+	}
 
 	if summary != "" {
-		sb.WriteString(fmt.Sprintf("\n## 📝 요약\n\n%s\n", summary))
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n%s\n", i18n.T("report.summary_md"), summary))
 	}
 
 	return sb.String()
@@ -0,0 +1,18 @@
+package output
+
+import "github.com/kso1204/gitday/internal/stats"
+
+// Options는 리포트 렌더링 옵션이다.
+type Options struct {
+	Compact   bool // 레포당 커밋 3개까지만 출력
+	ShowFiles bool // 커밋 아래에 파일별 변경 내역 출력
+	Stats     bool // 시간대/요일별 활동 통계 섹션 출력 (--stats)
+
+	GroupBy string // 커밋 묶음 기준: repo, type, scope, ticket, branch (터미널/마크다운)
+
+	Width    int    // 터미널 출력 너비 (0이면 터미널에서 감지)
+	Overflow string // 긴 커밋 메시지: wrap, truncate
+
+	Layout    string                 // CSV 레이아웃: timesheet, commits
+	Timesheet stats.TimesheetOptions // timesheet 레이아웃의 작업 시간 추정 값
+}
@@ -0,0 +1,70 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// PrintStandup은 스탠드업 형식(어제 한 일 / 오늘 할 일 / 블로커)으로 출력한다.
+// AI 요약(summary)이 있으면 세 섹션을 AI가 쓴 내용으로 대신한다.
+// "어제 한 일"은 opts.GroupBy에 따라 레포, 유형, 티켓 등으로 묶는다.
+func PrintStandup(results []git.RepoResult, rng period.Range, summary string, opts Options) {
+	fmt.Println(outStyles.title.Render(i18n.T("standup.title") + " · " + PeriodTitle(rng)))
+	fmt.Println()
+
+	if summary != "" {
+		fmt.Println(outStyles.summaryText.Render(wrapText(summary, TerminalWidth())))
+		return
+	}
+
+	fmt.Println(outStyles.summaryHeader.Render(i18n.T("standup.done")))
+	for _, sec := range reportSections(results, opts.GroupBy) {
+		if len(sec.commits) == 0 {
+			continue
+		}
+		fmt.Printf("  %s\n", outStyles.repo.Render(sec.title))
+		for _, c := range sec.commits {
+			fmt.Printf("    · %s %s\n", outStyles.msg.Render(c.Message), outStyles.stat.Render(c.Date.In(rng.Since.Location()).Format("01-02 15:04")))
+		}
+	}
+	fmt.Println()
+
+	// 오늘 할 일과 블로커는 커밋으로 알 수 없으므로 지어내지 않고 빈 칸으로 둔다
+	fmt.Println(outStyles.summaryHeader.Render(i18n.T("standup.plan")))
+	fmt.Println(outStyles.empty.Render("  ·"))
+	fmt.Println()
+
+	fmt.Println(outStyles.summaryHeader.Render(i18n.T("standup.blockers")))
+	fmt.Println(outStyles.empty.Render("  ·"))
+}
+
+// StandupMarkdown은 스탠드업 리포트를 마크다운으로 변환한다 (Slack/위키 붙여넣기용).
+func StandupMarkdown(results []git.RepoResult, rng period.Range, summary string, opts Options) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s · %s\n\n", i18n.T("standup.title"), PeriodTitle(rng)))
+
+	if summary != "" {
+		sb.WriteString(summary)
+		sb.WriteString("\n")
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("## %s\n\n", i18n.T("standup.done")))
+	for _, sec := range reportSections(results, opts.GroupBy) {
+		if len(sec.commits) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("- **%s**\n", sec.title))
+		for _, c := range sec.commits {
+			sb.WriteString(fmt.Sprintf("  - %s\n", c.Message))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\n## %s\n\n- \n", i18n.T("standup.plan")))
+	sb.WriteString(fmt.Sprintf("\n## %s\n\n- \n", i18n.T("standup.blockers")))
+	return sb.String()
+}
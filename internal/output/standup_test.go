@@ -0,0 +1,26 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+func TestStandupMarkdown_EmptyPlanAndBlockers(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{{Hash: "a1", Message: "결제 취소"}}},
+	}
+
+	md := StandupMarkdown(results, period.Range{}, "", Options{})
+
+	for _, want := range []string{"- **api**\n  - 결제 취소\n", "## 📋 오늘 할 일\n\n- \n", "## 🚧 블로커\n\n- \n"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("missing %q in:\n%s", want, md)
+		}
+	}
+	if strings.Contains(md, "없음") {
+		t.Errorf("blockers should be left for the user to fill in:\n%s", md)
+	}
+}
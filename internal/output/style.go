@@ -0,0 +1,79 @@
+package output
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+	"github.com/kso1204/gitday/internal/stats"
+	"github.com/muesli/termenv"
+)
+
+// styles는 터미널 출력에 쓰는 스타일 묶음이다.
+// 색을 끈 렌더러로 만들면 모든 스타일이 이스케이프 코드 없이 평문을 그대로 돌려준다.
+type styles struct {
+	title         lipgloss.Style
+	date          lipgloss.Style
+	repo          lipgloss.Style
+	hash          lipgloss.Style
+	msg           lipgloss.Style
+	stat          lipgloss.Style
+	summaryBar    lipgloss.Style
+	empty         lipgloss.Style
+	summaryText   lipgloss.Style
+	summaryHeader lipgloss.Style
+	warnHeader    lipgloss.Style
+	heat          [stats.HeatmapLevels]lipgloss.Style // 히트맵 단계별 색 (0 = 커밋 없음)
+}
+
+func newStyles(r *lipgloss.Renderer) styles {
+	return styles{
+		title:         r.NewStyle().Bold(true).Foreground(lipgloss.Color("12")),
+		date:          r.NewStyle().Foreground(lipgloss.Color("8")),
+		repo:          r.NewStyle().Bold(true).Foreground(lipgloss.Color("11")),
+		hash:          r.NewStyle().Foreground(lipgloss.Color("3")),
+		msg:           r.NewStyle().Foreground(lipgloss.Color("15")),
+		stat:          r.NewStyle().Foreground(lipgloss.Color("8")),
+		summaryBar:    r.NewStyle().Foreground(lipgloss.Color("8")),
+		empty:         r.NewStyle().Foreground(lipgloss.Color("8")).Italic(true),
+		summaryText:   r.NewStyle().Foreground(lipgloss.Color("14")),
+		summaryHeader: r.NewStyle().Bold(true).Foreground(lipgloss.Color("13")),
+		warnHeader:    r.NewStyle().Bold(true).Foreground(lipgloss.Color("9")),
+		heat: [stats.HeatmapLevels]lipgloss.Style{
+			r.NewStyle().Foreground(lipgloss.Color("240")),
+			r.NewStyle().Foreground(lipgloss.Color("22")),
+			r.NewStyle().Foreground(lipgloss.Color("28")),
+			r.NewStyle().Foreground(lipgloss.Color("34")),
+			r.NewStyle().Foreground(lipgloss.Color("46")),
+		},
+	}
+}
+
+// outStyles는 stdout(리포트), errStyles는 stderr(경고)용 스타일이다.
+// 두 스트림은 따로 파이프될 수 있어 TTY 여부를 각각 판단한다.
+var outStyles, errStyles styles
+
+func init() {
+	SetColor(true)
+}
+
+// SetColor는 터미널 출력의 색상 사용 여부를 정한다.
+// enabled여도 NO_COLOR 환경변수가 있거나 출력이 터미널이 아니면(파이프, 파일, cron 메일) 색을 쓰지 않는다.
+func SetColor(enabled bool) {
+	outStyles = newStyles(newRenderer(os.Stdout, enabled))
+	errStyles = newStyles(newRenderer(os.Stderr, enabled))
+}
+
+func newRenderer(f *os.File, enabled bool) *lipgloss.Renderer {
+	r := lipgloss.NewRenderer(f)
+	if !useColor(enabled, os.Getenv("NO_COLOR"), term.IsTerminal(f.Fd())) {
+		r.SetColorProfile(termenv.Ascii)
+	}
+	return r
+}
+
+// useColor는 설정, NO_COLOR 값, TTY 여부로 색상 사용 여부를 정한다.
+// NO_COLOR는 값이 비어 있지 않으면 끈다 (https://no-color.org).
+func useColor(enabled bool, noColor string, tty bool) bool {
+	return enabled && noColor == "" && tty
+}
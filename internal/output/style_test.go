@@ -0,0 +1,43 @@
+package output
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestUseColor(t *testing.T) {
+	cases := []struct {
+		enabled bool
+		noColor string
+		tty     bool
+		want    bool
+	}{
+		{true, "", true, true},
+		{false, "", true, false},
+		{true, "1", true, false},
+		{true, "", false, false},
+	}
+	for _, c := range cases {
+		if got := useColor(c.enabled, c.noColor, c.tty); got != c.want {
+			t.Errorf("useColor(%v, %q, %v) = %v, want %v", c.enabled, c.noColor, c.tty, got, c.want)
+		}
+	}
+}
+
+func TestStylesPlain(t *testing.T) {
+	r := lipgloss.NewRenderer(io.Discard)
+
+	r.SetColorProfile(termenv.ANSI256)
+	if got := newStyles(r).title.Render("gitday"); !strings.Contains(got, "\x1b[") {
+		t.Errorf("colored title = %q, want escape codes", got)
+	}
+
+	r.SetColorProfile(termenv.Ascii)
+	if got := newStyles(r).title.Render("gitday"); got != "gitday" {
+		t.Errorf("plain title = %q, want no escape codes", got)
+	}
+}
@@ -0,0 +1,156 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// TeamReport는 팀 리포트(gitday team)의 입력이다.
+type TeamReport struct {
+	Results   []git.RepoResult  // 작성자 필터 없이 수집한 전체 결과 (합계용)
+	Members   []git.Person      // 사람별 결과. 팀원이 설정되어 있으면 팀원만
+	Others    []git.Person      // 팀원이 아닌 작성자
+	Summaries map[string]string // 사람 이름별 AI 요약 (--summary)
+}
+
+// PrintTeam은 팀 리포트를 사람별 섹션으로 출력한다.
+// AI 요약이 있는 사람은 커밋 목록 대신 요약을 보여준다.
+func PrintTeam(report TeamReport, rng period.Range, opts Options) {
+	width := reportWidth(opts)
+
+	fmt.Println(outStyles.title.Render(i18n.T("team.title") + " · " + PeriodTitle(rng)))
+	fmt.Println()
+
+	for _, p := range report.Members {
+		commits := personCommits(p)
+		title := fmt.Sprintf("%s (%d commits, %s)", p.Name, len(commits), formatChurn(sectionChurn(commits)))
+		fmt.Println(outStyles.repo.Render(repoHeader(title, width)))
+		printIndented(i18n.T("team.repos", personRepos(p)), outStyles.stat, width)
+
+		if summary := report.Summaries[p.Name]; summary != "" {
+			printIndented(summary, outStyles.summaryText, width)
+			fmt.Println()
+			continue
+		}
+
+		shown := commits
+		if opts.Compact && len(shown) > 3 {
+			shown = shown[:3]
+		}
+		for _, c := range shown {
+			printIndented("· "+c.Message, outStyles.msg, width)
+		}
+		if len(shown) < len(commits) {
+			fmt.Printf("%s%s\n", commitIndent, outStyles.stat.Render(i18n.T("report.more", len(commits)-len(shown))))
+		}
+		if merges := personMerges(p); len(merges) > 0 {
+			printIndented(i18n.T("report.merged", git.MergeSummary(merges)), outStyles.stat, width)
+		}
+		fmt.Println()
+	}
+
+	if len(report.Others) > 0 {
+		fmt.Println(outStyles.stat.Render(wrapText(othersText(report.Others), width)))
+		fmt.Println()
+	}
+
+	bar := "📊 " + teamTotalsText(report)
+	fmt.Println(outStyles.summaryBar.Render(wrapText(bar, width)))
+}
+
+// printIndented는 text를 커밋 들여쓰기에 맞춰 줄바꿈하고 줄마다 style을 적용해 출력한다.
+func printIndented(text string, style lipgloss.Style, width int) {
+	for _, line := range strings.Split(wrapText(text, width-len(commitIndent)), "\n") {
+		fmt.Println(commitIndent + style.Render(line))
+	}
+}
+
+// TeamMarkdown은 팀 리포트를 마크다운으로 변환한다 (주간 다이제스트 공유용).
+func TeamMarkdown(report TeamReport, rng period.Range) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s · %s\n\n", i18n.T("team.title"), PeriodTitle(rng)))
+
+	for _, p := range report.Members {
+		commits := personCommits(p)
+		sb.WriteString(fmt.Sprintf("## %s (%d commits, %s)\n\n", p.Name, len(commits), formatChurn(sectionChurn(commits))))
+		sb.WriteString(fmt.Sprintf("_%s_\n\n", i18n.T("team.repos", personRepos(p))))
+
+		if summary := report.Summaries[p.Name]; summary != "" {
+			sb.WriteString(summary + "\n\n")
+			continue
+		}
+		for _, c := range commits {
+			sb.WriteString(fmt.Sprintf("- `%s` %s\n", c.Hash, c.Message))
+		}
+		if merges := personMerges(p); len(merges) > 0 {
+			sb.WriteString(fmt.Sprintf("- _%s_\n", i18n.T("report.merged", git.MergeSummary(merges))))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.Others) > 0 {
+		sb.WriteString(fmt.Sprintf("_%s_\n\n", othersText(report.Others)))
+	}
+	sb.WriteString(fmt.Sprintf("---\n\n📊 **%s**\n", teamTotalsText(report)))
+	return sb.String()
+}
+
+// personCommits는 한 사람의 커밋을 레포 순서대로 이어 붙인다.
+// 여러 레포에 커밋했으면 메시지 앞에 [레포]를 붙인다. 원래 커밋은 바꾸지 않는다.
+func personCommits(p git.Person) []git.Commit {
+	var commits []git.Commit
+	for _, r := range p.Results {
+		for _, c := range r.Commits {
+			if len(p.Results) > 1 {
+				c.Message = "[" + r.Name + "] " + c.Message
+			}
+			commits = append(commits, c)
+		}
+	}
+	return commits
+}
+
+func personMerges(p git.Person) []git.Commit {
+	var merges []git.Commit
+	for _, r := range p.Results {
+		merges = append(merges, r.Merges...)
+	}
+	return merges
+}
+
+// personRepos는 "api 3 · web 2"처럼 그 사람이 커밋한 레포와 커밋 수를 나열한다.
+func personRepos(p git.Person) string {
+	repos := make([]string, 0, len(p.Results))
+	for _, r := range p.Results {
+		repos = append(repos, fmt.Sprintf("%s %d", r.Name, len(r.Commits)))
+	}
+	return strings.Join(repos, " · ")
+}
+
+// othersText는 팀원이 아닌 작성자를 한 줄로 나열한다.
+func othersText(others []git.Person) string {
+	names := make([]string, len(others))
+	for i, p := range others {
+		names[i] = fmt.Sprintf("%s (%d)", p.Name, p.Commits())
+	}
+	return i18n.T("team.others", len(others), strings.Join(names, ", "))
+}
+
+func teamTotalsText(report TeamReport) string {
+	commits, ins, del := 0, 0, 0
+	for _, r := range report.Results {
+		commits += len(r.Commits)
+		ins += r.TotalInsertions()
+		del += r.TotalDeletions()
+	}
+	text := i18n.T("team.totals", commits, len(report.Members), len(report.Results), formatChurn(ins, del))
+	if merges := totalMerges(report.Results); merges > 0 {
+		text += " | " + i18n.T("report.merges", merges)
+	}
+	return text
+}
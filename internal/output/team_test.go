@@ -0,0 +1,61 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+func TestTeamMarkdown(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{
+			{Hash: "a1", Author: "wook", Message: "결제 취소", Insertions: 30, Deletions: 2},
+			{Hash: "a2", Author: "lee", Message: "로그 정리", Insertions: 1, Deletions: 5},
+		}},
+		{Name: "web", Commits: []git.Commit{
+			{Hash: "w1", Author: "wook", Message: "로그인 화면", Insertions: 10},
+		}},
+	}
+	team, _ := git.NewTeam([]git.Member{{Name: "Wook Kim", Aliases: []string{"wook"}}})
+	report := TeamReport{Results: results}
+	report.Members, report.Others = team.ByPerson(results)
+
+	md := TeamMarkdown(report, period.Range{})
+
+	wantOrder := []string{
+		"## Wook Kim (2 commits, +40 -2)",
+		"_📁 api 1 · web 1_",
+		"- `a1` [api] 결제 취소",
+		"- `w1` [web] 로그인 화면",
+		"_그 외 1명: lee (1)_",
+		"총 3 commits | 1명 | 2개 프로젝트 | +41 -7",
+	}
+	pos := 0
+	for _, want := range wantOrder {
+		i := strings.Index(md[pos:], want)
+		if i < 0 {
+			t.Fatalf("missing (or out of order) %q in:\n%s", want, md)
+		}
+		pos += i + len(want)
+	}
+}
+
+func TestTeamMarkdown_Summary(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "api", Commits: []git.Commit{{Hash: "a1", Author: "wook", Message: "결제 취소"}}},
+	}
+	team, _ := git.NewTeam(nil)
+	report := TeamReport{Results: results, Summaries: map[string]string{"wook": "결제 취소 흐름을 마무리했다."}}
+	report.Members, report.Others = team.ByPerson(results)
+
+	md := TeamMarkdown(report, period.Range{})
+
+	if !strings.Contains(md, "결제 취소 흐름을 마무리했다.") {
+		t.Errorf("summary missing in:\n%s", md)
+	}
+	if strings.Contains(md, "`a1`") {
+		t.Errorf("commits should be replaced by the summary:\n%s", md)
+	}
+}
@@ -0,0 +1,174 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// TemplateData는 사용자 템플릿(--template)에 전달되는 데이터이다.
+// 템플릿에서는 {{.Period.Since}}, {{range .Repos}}...{{end}}처럼 접근한다.
+// 필드 이름은 사용자 템플릿이 의존하므로 바꾸지 않는다.
+type TemplateData struct {
+	Title   string         // "2026-02-26 (목)" 같은 기간 제목
+	Period  TemplatePeriod // 조회 기간
+	Repos   []TemplateRepo // 커밋이 있는 레포 목록 (정렬 설정 순서)
+	Totals  TemplateTotals // 전체 합계
+	Summary string         // AI 요약 (--summary가 없으면 빈 문자열)
+}
+
+// TemplatePeriod는 조회 기간이다. 시각은 timezone 설정 기준이다.
+type TemplatePeriod struct {
+	Name     string    // today, week, month, standup, custom ...
+	Since    time.Time // 시작 시각 (포함)
+	Until    time.Time // 종료 시각 (미포함)
+	FirstDay time.Time // 시작 날짜 (day_start 반영)
+	LastDay  time.Time // 마지막 날짜 (day_start 반영)
+}
+
+// TemplateRepo는 레포 하나의 커밋 목록과 합계이다.
+type TemplateRepo struct {
+	Name       string       // 레포 이름
+	Title      string       // 워크트리/서브모듈 표시를 붙인 이름
+	Path       string       // 레포 경로
+	Branch     string       // 현재 브랜치
+	Commits    []git.Commit // 커밋 목록 (.Hash .Message .Author .Email .CoAuthors .Date .Files .Insertions .Deletions .Tickets .Branch .Changes)
+	Files      int
+	Insertions int
+	Deletions  int
+}
+
+// TemplateTotals는 전체 합계이다.
+type TemplateTotals struct {
+	Repos      int
+	Commits    int
+	Files      int
+	Insertions int
+	Deletions  int
+}
+
+// templateFuncs는 템플릿에서 쓸 수 있는 헬퍼 함수이다.
+var templateFuncs = template.FuncMap{
+	// date "2006-01-02 15:04" .Date
+	"date": func(layout string, t time.Time) string { return t.Format(layout) },
+	// weekday .Date → "목" (language 설정을 따른다)
+	"weekday": func(t time.Time) string { return i18n.Weekday(t.Weekday()) },
+	// truncate 50 .Message → 50자 넘으면 잘라서 "…"를 붙인다
+	"truncate": truncate,
+	// plural .Totals.Commits "commit" "commits" → "3 commits"
+	"plural": plural,
+	// churn .Insertions .Deletions → "+12 -3"
+	"churn": formatChurn,
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"indent": func(n int, s string) string {
+		pad := strings.Repeat(" ", n)
+		return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+	},
+}
+
+// LoadTemplate은 파일에서 사용자 템플릿을 읽는다.
+func LoadTemplate(path string) (*template.Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, i18n.Errorf("err.template_read", err)
+	}
+	return ParseTemplate(filepath.Base(path), string(data))
+}
+
+// ParseTemplate은 템플릿 문자열을 헬퍼 함수와 함께 파싱한다.
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, i18n.Errorf("err.template_parse", err)
+	}
+	return tmpl, nil
+}
+
+// RenderTemplate은 리포트를 사용자 템플릿으로 렌더링한다.
+func RenderTemplate(tmpl *template.Template, results []git.RepoResult, rng period.Range, summary string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, NewTemplateData(results, rng, summary)); err != nil {
+		return nil, i18n.Errorf("err.template_exec", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// NewTemplateData는 수집 결과를 템플릿 데이터로 변환한다.
+// 커밋 시각은 기간의 timezone으로 맞춘다.
+func NewTemplateData(results []git.RepoResult, rng period.Range, summary string) TemplateData {
+	cal := rng.Calendar()
+	data := TemplateData{
+		Title: PeriodTitle(rng),
+		Period: TemplatePeriod{
+			Name:     rng.Name,
+			Since:    cal.In(rng.Since),
+			Until:    cal.In(rng.Until),
+			FirstDay: rng.FirstDay(),
+			LastDay:  rng.LastDay(),
+		},
+		Summary: summary,
+	}
+
+	for _, r := range results {
+		commits := make([]git.Commit, len(r.Commits))
+		for i, c := range r.Commits {
+			c.Date = cal.In(c.Date)
+			commits[i] = c
+		}
+		repo := TemplateRepo{
+			Name:       r.Name,
+			Title:      repoTitle(r),
+			Path:       r.Path,
+			Branch:     r.Branch,
+			Commits:    commits,
+			Files:      r.TotalFiles(),
+			Insertions: r.TotalInsertions(),
+			Deletions:  r.TotalDeletions(),
+		}
+		data.Repos = append(data.Repos, repo)
+
+		data.Totals.Repos++
+		data.Totals.Commits += len(commits)
+		data.Totals.Files += repo.Files
+		data.Totals.Insertions += repo.Insertions
+		data.Totals.Deletions += repo.Deletions
+	}
+	return data
+}
+
+// truncate는 문자열을 n글자(룬 기준)로 자른다. 잘린 경우 끝에 "…"를 붙인다.
+func truncate(n int, s string) string {
+	if n <= 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	if n == 1 {
+		return "…"
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// plural은 개수와 단수/복수형 단어를 합친다. 복수형을 생략하면 단수형에 "s"를 붙인다.
+func plural(n int, singular string, pluralForm ...string) string {
+	word := singular
+	if n != 1 {
+		if len(pluralForm) > 0 {
+			word = pluralForm[0]
+		} else {
+			word = singular + "s"
+		}
+	}
+	return fmt.Sprintf("%d %s", n, word)
+}
@@ -0,0 +1,59 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	results, rng := jsonFixture()
+
+	tmpl, err := ParseTemplate("test", `{{.Title}} {{plural .Totals.Commits "commit"}}
+{{range .Repos}}{{.Name}} {{churn .Insertions .Deletions}}
+{{range .Commits}}- {{date "15:04" .Date}} {{truncate 4 .Message}}
+{{end}}{{end}}{{.Summary}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := RenderTemplate(tmpl, results, rng, "요약")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "2026-02-26 (목) 1 commit\nrpg +30 -4\n- 15:00 전투 …\n요약"
+	if string(out) != want {
+		t.Errorf("got %q, want %q", out, want)
+	}
+}
+
+func TestParseTemplateError(t *testing.T) {
+	if _, err := ParseTemplate("bad", "{{.Repos"); err == nil {
+		t.Error("unterminated action should fail")
+	}
+
+	tmpl, err := ParseTemplate("field", "{{.Nope}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, rng := jsonFixture()
+	if _, err := RenderTemplate(tmpl, results, rng, ""); err == nil || !strings.Contains(err.Error(), "템플릿 실행 실패") {
+		t.Errorf("unknown field err = %v", err)
+	}
+}
+
+func TestPlural(t *testing.T) {
+	cases := []struct {
+		n    int
+		args []string
+		want string
+	}{
+		{1, []string{"commit"}, "1 commit"},
+		{0, []string{"commit"}, "0 commits"},
+		{2, []string{"repo", "repositories"}, "2 repositories"},
+	}
+	for _, c := range cases {
+		if got := plural(c.n, c.args[0], c.args[1:]...); got != c.want {
+			t.Errorf("plural(%d, %v) = %q, want %q", c.n, c.args, got, c.want)
+		}
+	}
+}
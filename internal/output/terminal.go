@@ -2,124 +2,181 @@ package output
 
 import (
 	"fmt"
+	"os"
 	"strings"
-	"time"
 
-	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/period"
 )
 
-var (
-	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("12"))
+// PrintReport는 리포트를 터미널 너비에 맞춰 출력한다.
+// 커밋은 레포마다 해시/메시지/변경 통계 열을 맞추고, 긴 메시지는 opts.Overflow에 따라 줄바꿈하거나 자른다.
+func PrintReport(results []git.RepoResult, rng period.Range, opts Options) {
+	width := reportWidth(opts)
 
-	dateStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("8"))
-
-	repoStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("11"))
-
-	hashStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("3"))
-
-	msgStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("15"))
-
-	statStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("8"))
-
-	summaryBarStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("8"))
-
-	emptyStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("8")).
-			Italic(true)
-)
-
-func PrintReport(results []git.RepoResult, since, until time.Time, compact bool) {
 	// 헤더
-	weekday := weekdayKo(since.Weekday())
-	header := fmt.Sprintf("📅 %s (%s)", since.Format("2006-01-02"), weekday)
-	fmt.Println(titleStyle.Render(header))
+	header := "📅 " + PeriodTitle(rng)
+	fmt.Println(outStyles.title.Render(header))
 	fmt.Println()
 
 	totalCommits := 0
 	totalFiles := 0
+	totalIns, totalDel := 0, 0
 
 	for _, r := range results {
-		commitCount := len(r.Commits)
-		totalCommits += commitCount
+		totalCommits += len(r.Commits)
+		totalFiles += r.TotalFiles()
+		totalIns += r.TotalInsertions()
+		totalDel += r.TotalDeletions()
+	}
 
-		fileCount := 0
-		for _, c := range r.Commits {
-			fileCount += c.Files
-		}
-		totalFiles += fileCount
+	for _, sec := range reportSections(results, opts.GroupBy) {
+		commitCount := len(sec.commits)
+
+		// 레포(묶음) 헤더
+		fmt.Println(outStyles.repo.Render(repoHeader(sec.heading(), width)))
 
-		// 레포 헤더
-		repoHeader := fmt.Sprintf("━━ %s (%d commits) ", r.Name, commitCount)
-		padding := 50 - len(repoHeader)
-		if padding < 3 {
-			padding = 3
+		// 커밋 목록 (간략 모드는 첫 3개만)
+		commits := sec.commits
+		if opts.Compact && commitCount > 3 {
+			commits = commits[:3]
 		}
-		repoHeader += strings.Repeat("━", padding)
-		fmt.Println(repoStyle.Render(repoHeader))
-
-		// 커밋 목록
-		if compact {
-			// 간략: 첫 3개만
-			limit := 3
-			if commitCount < limit {
-				limit = commitCount
-			}
-			for _, c := range r.Commits[:limit] {
-				printCommit(c)
-			}
-			if commitCount > 3 {
-				fmt.Printf("  %s\n", statStyle.Render(fmt.Sprintf("... +%d more", commitCount-3)))
-			}
-		} else {
-			for _, c := range r.Commits {
-				printCommit(c)
+		layout := newCommitLayout(commits, width, opts.Overflow)
+		for _, c := range commits {
+			printCommit(c, layout, width, opts)
+		}
+		if len(commits) < commitCount {
+			fmt.Printf("%s%s\n", commitIndent, outStyles.stat.Render(i18n.T("report.more", commitCount-len(commits))))
+		}
+		if sec.merges != "" {
+			merged := wrapText(i18n.T("report.merged", sec.merges), width-len(commitIndent))
+			for _, line := range strings.Split(merged, "\n") {
+				fmt.Println(commitIndent + outStyles.stat.Render(line))
 			}
 		}
 		fmt.Println()
 	}
 
 	// 하단 통계
-	bar := fmt.Sprintf("📊 총 %d commits | %d개 프로젝트 | %d files changed",
-		totalCommits, len(results), totalFiles)
-	fmt.Println(summaryBarStyle.Render(bar))
+	bar := "📊 " + totalsText(results, totalCommits, totalFiles, totalIns, totalDel)
+	fmt.Println(outStyles.summaryBar.Render(wrapText(bar, width)))
+
+	if opts.Stats {
+		printActivity(results, rng)
+	}
 }
 
-func printCommit(c git.Commit) {
-	hash := hashStyle.Render(c.Hash)
-	msg := msgStyle.Render(c.Message)
+func printCommit(c git.Commit, layout commitLayout, width int, opts Options) {
+	hash := outStyles.hash.Render(padRight(c.Hash, layout.hashWidth))
+	indent := strings.Repeat(" ", layout.messageColumn())
+	lines := layout.messageLines(c.Message)
+	stat := commitStatText(c)
 
-	if c.Files > 0 {
-		stat := statStyle.Render(fmt.Sprintf("(%d files)", c.Files))
-		fmt.Printf("  %s %s %s\n", hash, msg, stat)
-	} else {
-		fmt.Printf("  %s %s\n", hash, msg)
+	first := commitIndent + hash + " " + outStyles.msg.Render(lines[0])
+	if stat != "" && layout.statInline {
+		first = commitIndent + hash + " " + outStyles.msg.Render(padRight(lines[0], layout.messageWidth)) +
+			"  " + outStyles.stat.Render(stat)
+	}
+	fmt.Println(first)
+	for _, line := range lines[1:] {
+		fmt.Println(indent + outStyles.msg.Render(line))
+	}
+	if stat != "" && !layout.statInline {
+		fmt.Println(indent + outStyles.stat.Render(stat))
 	}
-}
 
-var summaryTextStyle = lipgloss.NewStyle().
-	Foreground(lipgloss.Color("14"))
+	if opts.ShowFiles {
+		printChanges(c.Changes, indent, width)
+	}
+}
 
-var summaryHeaderStyle = lipgloss.NewStyle().
-	Bold(true).
-	Foreground(lipgloss.Color("13"))
+// printChanges는 커밋의 파일별 변경 내역을 트리 형태로 출력한다.
+// 한 줄에 들어가지 않는 경로는 앞부분을 "…"로 줄인다.
+func printChanges(changes []git.FileChange, indent string, width int) {
+	for i, fc := range changes {
+		branch := "├─"
+		if i == len(changes)-1 {
+			branch = "└─"
+		}
+		churn := formatFileChurn(fc)
+		path := fc.Path
+		if avail := width - len(indent) - 4 - ansi.StringWidth(churn); ansi.StringWidth(path) > avail && avail > 1 {
+			path = ansi.TruncateLeft(path, ansi.StringWidth(path)-avail+1, "…")
+		}
+		fmt.Printf("%s%s %s %s\n", indent, outStyles.stat.Render(branch), path, outStyles.stat.Render(churn))
+	}
+}
 
 func PrintSummary(text string) {
 	fmt.Println()
-	fmt.Println(summaryHeaderStyle.Render("📝 오늘의 요약"))
-	fmt.Println(summaryTextStyle.Render(text))
+	fmt.Println(outStyles.summaryHeader.Render(i18n.T("report.summary")))
+	fmt.Println(outStyles.summaryText.Render(wrapText(text, TerminalWidth())))
+}
+
+// PrintWarnings는 로그 수집에 실패한 레포 목록을 stderr로 출력한다.
+// 리포트를 stdout으로 파이프해도 섞이지 않도록 stderr를 쓴다.
+func PrintWarnings(errs []*git.RepoError) {
+	if len(errs) == 0 {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, errStyles.warnHeader.Render(i18n.T("report.warnings", len(errs))))
+	for _, e := range errs {
+		msg := e.Stderr
+		if msg == "" {
+			msg = e.Err.Error()
+		}
+		// git stderr는 여러 줄일 수 있어 첫 줄만 보여준다
+		msg, _, _ = strings.Cut(msg, "\n")
+
+		code := i18n.T("report.git_failed")
+		if e.ExitCode >= 0 {
+			code = fmt.Sprintf("exit %d", e.ExitCode)
+		}
+		fmt.Fprintf(os.Stderr, "  %s %s\n", errStyles.repo.Render(e.Path), errStyles.stat.Render("("+code+")"))
+		fmt.Fprintf(os.Stderr, "    %s\n", msg)
+	}
+	fmt.Fprintln(os.Stderr)
 }
 
-func weekdayKo(w time.Weekday) string {
-	days := [...]string{"일", "월", "화", "수", "목", "금", "토"}
-	return days[w]
+// PeriodTitle은 리포트 헤더의 날짜 표시를 만든다.
+// 하루짜리 기간은 "2026-02-26 (목)", 여러 날이면 "2026-02-23 (월) ~ 2026-02-26 (목)"이다.
+// 날짜는 설정된 하루 시작 시각(day_start) 기준의 논리적 날짜이고, 요일은 language 설정을 따른다.
+func PeriodTitle(rng period.Range) string {
+	first := rng.FirstDay()
+	title := fmt.Sprintf("%s (%s)", first.Format("2006-01-02"), i18n.Weekday(first.Weekday()))
+	if !rng.MultiDay() {
+		return title
+	}
+	last := rng.LastDay()
+	return fmt.Sprintf("%s ~ %s (%s)", title, last.Format("2006-01-02"), i18n.Weekday(last.Weekday()))
+}
+
+// repoTitle은 레포 이름에 워크트리/서브모듈/bare 표시를 붙인다.
+func repoTitle(r git.RepoResult) string {
+	if label := r.Label(); label != "" {
+		return fmt.Sprintf("%s [%s]", r.Name, label)
+	}
+	return r.Name
+}
+
+// formatChurn은 추가/삭제 라인 수를 "+12 -3" 형태로 포맷한다.
+func formatChurn(insertions, deletions int) string {
+	return fmt.Sprintf("+%d -%d", insertions, deletions)
+}
+
+// formatCommitStat은 커밋의 변경 통계를 "3 files, +12 -3" 형태로 포맷한다.
+func formatCommitStat(c git.Commit) string {
+	return fmt.Sprintf("%d files, %s", c.Files, formatChurn(c.Insertions, c.Deletions))
+}
+
+// formatFileChurn은 파일 단위 변경량을 포맷한다. 바이너리는 라인 수 대신 표시만 한다.
+func formatFileChurn(fc git.FileChange) string {
+	if fc.Binary {
+		return "(binary)"
+	}
+	return formatChurn(fc.Insertions, fc.Deletions)
 }
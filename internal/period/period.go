@@ -0,0 +1,281 @@
+package period
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// Range는 리포트 대상 기간 [Since, Until)이다.
+type Range struct {
+	Name  string // today, yesterday, week, month, last-7d, custom ...
+	Since time.Time
+	Until time.Time
+
+	// DayStart는 기간을 만든 Calendar의 하루 시작 시각이다.
+	// 날짜 표시(FirstDay/LastDay)를 논리적 하루 기준으로 계산할 때 쓴다.
+	DayStart time.Duration
+}
+
+// 기간 이름
+const (
+	Today     = "today"
+	Yesterday = "yesterday"
+	Week      = "week"
+	Month     = "month"
+	Custom    = "custom"
+)
+
+var (
+	lastNPattern = regexp.MustCompile(`^last-(\d+)([dwm])$`)
+	agoPattern   = regexp.MustCompile(`^(\d+)\s*(minute|hour|day|week|month)s?\s+ago$`)
+)
+
+// Calendar는 하루의 경계를 정의한다. 모든 기간 계산은 Calendar를 거친다.
+// zero value는 now의 타임존에서 자정에 하루가 시작하는 달력이다.
+type Calendar struct {
+	Location *time.Location // nil이면 now의 타임존
+	DayStart time.Duration  // 하루 시작 시각 (4h면 04:00 전 커밋은 전날로 본다)
+}
+
+// NewCalendar는 설정 값(timezone, day_start)으로 Calendar를 만든다.
+// timezone은 IANA 이름("Asia/Seoul")이며 비어 있거나 "Local"이면 시스템 타임존이다.
+// dayStart는 "04:00" 형식이며 비어 있으면 자정이다.
+func NewCalendar(timezone, dayStart string) (Calendar, error) {
+	c := Calendar{Location: time.Local}
+
+	if timezone = strings.TrimSpace(timezone); timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return Calendar{}, i18n.Errorf("err.timezone", timezone)
+		}
+		c.Location = loc
+	}
+
+	if dayStart = strings.TrimSpace(dayStart); dayStart != "" {
+		t, err := time.Parse("15:04", dayStart)
+		if err != nil {
+			return Calendar{}, i18n.Errorf("err.day_start", dayStart)
+		}
+		c.DayStart = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+
+	return c, nil
+}
+
+// In은 t를 달력의 타임존으로 변환한다.
+func (c Calendar) In(t time.Time) time.Time {
+	if c.Location == nil {
+		return t
+	}
+	return t.In(c.Location)
+}
+
+// Now는 달력 타임존의 현재 시각이다.
+func (c Calendar) Now() time.Time {
+	return c.In(time.Now())
+}
+
+// at은 날짜 day(연/월/일만 사용)의 하루 시작 시각을 만든다.
+func (c Calendar) at(day time.Time) time.Time {
+	h := int(c.DayStart / time.Hour)
+	m := int(c.DayStart % time.Hour / time.Minute)
+	return time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, day.Location())
+}
+
+// addMonths는 t에서 n개월 떨어진 같은 날을 반환한다. 그 달에 없는 날(3/31의 한 달 전)은 말일로 맞춘다.
+// time.AddDate는 2/31을 3/3으로 넘겨 버리므로 직접 계산한다.
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(t.Day(), lastDay), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// StartOfDay는 t가 속한 논리적 하루의 시작 시각을 반환한다.
+// DayStart가 04:00이면 01:00 커밋은 전날 04:00부터 시작하는 하루에 속한다.
+func (c Calendar) StartOfDay(t time.Time) time.Time {
+	t = c.In(t)
+	start := c.at(t)
+	if t.Before(start) {
+		start = c.at(t.AddDate(0, 0, -1))
+	}
+	return start
+}
+
+// StartOfWeek는 t가 속한 주의 월요일 하루 시작 시각을 반환한다.
+func (c Calendar) StartOfWeek(t time.Time) time.Time {
+	today := c.StartOfDay(t)
+	weekday := int(today.Weekday())
+	if weekday == 0 {
+		weekday = 7 // 일요일
+	}
+	return c.at(today.AddDate(0, 0, -(weekday - 1)))
+}
+
+// Parse는 기간 표현식을 now 기준의 Range로 변환한다.
+//
+//	today, yesterday       오늘 / 어제 하루
+//	week, month            이번 주 월요일 / 이번 달 1일부터 지금까지
+//	last-7d, last-2w       오늘을 포함한 최근 N일 / N주
+//	last-6m                오늘을 포함한 최근 N개월 (6개월 전 다음 날부터)
+//	"3 days ago", 날짜      해당 시점부터 지금까지 (ParseTime 참고)
+func (c Calendar) Parse(expr string, now time.Time) (Range, error) {
+	expr = strings.ToLower(strings.TrimSpace(expr))
+	now = c.In(now)
+	today := c.StartOfDay(now)
+
+	r := Range{Until: now, DayStart: c.DayStart}
+	switch expr {
+	case "", Today:
+		r.Name, r.Since = Today, today
+		return r, nil
+	case Yesterday:
+		r.Name, r.Since, r.Until = Yesterday, c.at(today.AddDate(0, 0, -1)), today
+		return r, nil
+	case Week:
+		r.Name, r.Since = Week, c.StartOfWeek(now)
+		return r, nil
+	case Month:
+		r.Name, r.Since = Month, c.at(today.AddDate(0, 0, 1-today.Day()))
+		return r, nil
+	}
+
+	if m := lastNPattern.FindStringSubmatch(expr); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if n <= 0 {
+			return Range{}, i18n.Errorf("err.period_count", expr)
+		}
+		switch m[2] {
+		case "d":
+			r.Since = c.at(today.AddDate(0, 0, -(n - 1)))
+		case "w":
+			r.Since = c.at(today.AddDate(0, 0, -(n*7 - 1)))
+		case "m":
+			r.Since = c.at(addMonths(today, -n).AddDate(0, 0, 1))
+		}
+		r.Name = expr
+		return r, nil
+	}
+
+	since, err := c.ParseTime(expr, now, false)
+	if err != nil {
+		return Range{}, i18n.Errorf("err.period_unknown", expr)
+	}
+	r.Name, r.Since = Custom, since
+	return r, nil
+}
+
+// Resolve는 기간 표현식에 --since/--until 값을 덮어써 최종 Range를 만든다.
+// sinceExpr/untilExpr이 비어 있으면 periodExpr의 값을 그대로 쓴다.
+func (c Calendar) Resolve(periodExpr, sinceExpr, untilExpr string, now time.Time) (Range, error) {
+	now = c.In(now)
+	r, err := c.Parse(periodExpr, now)
+	if err != nil {
+		return Range{}, err
+	}
+
+	if sinceExpr != "" {
+		if r.Since, err = c.ParseTime(sinceExpr, now, false); err != nil {
+			return Range{}, err
+		}
+		r.Name = Custom
+		r.Until = now
+	}
+	if untilExpr != "" {
+		if r.Until, err = c.ParseTime(untilExpr, now, true); err != nil {
+			return Range{}, err
+		}
+		r.Name = Custom
+	}
+
+	if !r.Since.Before(r.Until) {
+		return Range{}, i18n.Errorf("err.range_order",
+			r.Since.Format("2006-01-02 15:04"), r.Until.Format("2006-01-02 15:04"))
+	}
+	return r, nil
+}
+
+// ParseTime은 --since/--until 값을 시각으로 변환한다.
+// 날짜만 주어지면 isEnd가 false일 때 그날의 시작, true일 때 다음날의 시작(그날 끝까지 포함)이다.
+//
+//	2026-10-01, 2026-10-01 15:04, RFC 3339
+//	today, yesterday, now
+//	"3 days ago", "2 hours ago", "1 week ago"
+func (c Calendar) ParseTime(expr string, now time.Time, isEnd bool) (time.Time, error) {
+	raw := strings.TrimSpace(expr)
+	expr = strings.ToLower(raw)
+	now = c.In(now)
+	loc := now.Location()
+
+	dayBound := func(day time.Time) time.Time {
+		if isEnd {
+			day = day.AddDate(0, 0, 1)
+		}
+		return c.at(day)
+	}
+
+	switch expr {
+	case "now":
+		return now, nil
+	case Today:
+		return dayBound(c.StartOfDay(now)), nil
+	case Yesterday:
+		return dayBound(c.StartOfDay(now).AddDate(0, 0, -1)), nil
+	}
+
+	if m := agoPattern.FindStringSubmatch(expr); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		switch m[2] {
+		case "minute":
+			return now.Add(-time.Duration(n) * time.Minute), nil
+		case "hour":
+			return now.Add(-time.Duration(n) * time.Hour), nil
+		case "day":
+			return now.AddDate(0, 0, -n), nil
+		case "week":
+			return now.AddDate(0, 0, -7*n), nil
+		case "month":
+			return addMonths(now, -n), nil
+		}
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", expr, loc); err == nil {
+		return dayBound(t), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04", expr, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return c.In(t), nil
+	}
+
+	return time.Time{}, i18n.Errorf("err.time_parse", expr)
+}
+
+// Calendar는 기간을 만든 달력(타임존, 하루 시작 시각)을 돌려준다.
+// 기간 안의 커밋을 날짜별로 나눌 때 같은 경계를 쓰기 위해 필요하다.
+func (r Range) Calendar() Calendar {
+	return Calendar{Location: r.Since.Location(), DayStart: r.DayStart}
+}
+
+// FirstDay는 기간이 시작하는 논리적 날짜(00:00)를 반환한다.
+func (r Range) FirstDay() time.Time {
+	return dateOf(r.Since.Add(-r.DayStart))
+}
+
+// LastDay는 기간에 포함되는 마지막 논리적 날짜(00:00)를 반환한다.
+func (r Range) LastDay() time.Time {
+	return dateOf(r.Until.Add(-time.Nanosecond - r.DayStart))
+}
+
+// MultiDay는 기간이 하루를 넘는지 여부이다. 리포트 헤더를 날짜 범위로 표시할 때 쓴다.
+func (r Range) MultiDay() bool {
+	return r.LastDay().After(r.FirstDay())
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
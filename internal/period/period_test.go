@@ -0,0 +1,235 @@
+package period
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// local은 now의 타임존에서 자정에 하루가 시작하는 기본 달력이다.
+var local Calendar
+
+// 2026-10-15 (목) 14:30 KST
+var now = time.Date(2026, 10, 15, 14, 30, 0, 0, time.FixedZone("KST", 9*60*60))
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr  string
+		name  string
+		since time.Time
+		until time.Time
+	}{
+		{"", Today, day(2026, 10, 15), now},
+		{"today", Today, day(2026, 10, 15), now},
+		{"yesterday", Yesterday, day(2026, 10, 14), day(2026, 10, 15)},
+		{"week", Week, day(2026, 10, 12), now},
+		{"month", Month, day(2026, 10, 1), now},
+		{"last-7d", "last-7d", day(2026, 10, 9), now},
+		{"last-1d", "last-1d", day(2026, 10, 15), now},
+		{"last-2w", "last-2w", day(2026, 10, 2), now},
+		{"last-6m", "last-6m", day(2026, 4, 16), now},
+		{"3 days ago", Custom, now.AddDate(0, 0, -3), now},
+		{"2026-10-01", Custom, day(2026, 10, 1), now},
+	}
+
+	for _, tt := range tests {
+		r, err := local.Parse(tt.expr, now)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.expr, err)
+			continue
+		}
+		if r.Name != tt.name || !r.Since.Equal(tt.since) || !r.Until.Equal(tt.until) {
+			t.Errorf("Parse(%q) = {%s %s %s}, want {%s %s %s}", tt.expr,
+				r.Name, r.Since, r.Until, tt.name, tt.since, tt.until)
+		}
+	}
+
+	for _, bad := range []string{"fortnight", "last-0d", "last-3y"} {
+		if _, err := local.Parse(bad, now); err == nil {
+			t.Errorf("Parse(%q): expected error", bad)
+		}
+	}
+}
+
+func TestParse_LastMonthsAtMonthEnd(t *testing.T) {
+	tests := []struct {
+		now   time.Time
+		expr  string
+		since time.Time
+	}{
+		{day(2026, 3, 31), "last-1m", day(2026, 3, 1)}, // 2/31이 아니라 2/28 다음 날
+		{day(2026, 8, 31), "last-6m", day(2026, 3, 1)},
+		{day(2028, 3, 31), "last-1m", day(2028, 3, 1)}, // 윤년 2/29 다음 날
+		{day(2026, 5, 31), "last-1m", day(2026, 5, 1)},
+		{day(2026, 1, 31), "last-2m", day(2025, 12, 1)},
+	}
+	for _, tt := range tests {
+		r, err := local.Parse(tt.expr, tt.now.Add(10*time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !r.Since.Equal(tt.since) {
+			t.Errorf("Parse(%q) on %s: since = %s, want %s", tt.expr, tt.now.Format("2006-01-02"), r.Since, tt.since)
+		}
+	}
+}
+
+func TestParseTime_MonthsAgoAtMonthEnd(t *testing.T) {
+	end := time.Date(2026, 3, 31, 9, 15, 0, 0, now.Location())
+	got, err := local.ParseTime("1 month ago", end, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 2, 28, 9, 15, 0, 0, now.Location()); !got.Equal(want) {
+		t.Errorf("1 month ago on 03-31 = %s, want %s", got, want)
+	}
+}
+
+func TestParse_ErrorLanguage(t *testing.T) {
+	defer i18n.SetLanguage(i18n.DefaultLanguage)
+
+	i18n.SetLanguage("en")
+	_, err := local.Parse("fortnight", now)
+	if err == nil || !strings.HasPrefix(err.Error(), "unknown period: fortnight") {
+		t.Errorf("Parse(fortnight) with --lang en = %v", err)
+	}
+}
+
+func TestStartOfWeek_Sunday(t *testing.T) {
+	sunday := time.Date(2026, 10, 18, 23, 0, 0, 0, now.Location())
+	if got := local.StartOfWeek(sunday); !got.Equal(day(2026, 10, 12)) {
+		t.Errorf("local.StartOfWeek(sunday) = %s, want 2026-10-12", got)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r, err := local.Resolve("today", "2026-10-01", "2026-10-10", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name != Custom || !r.Since.Equal(day(2026, 10, 1)) || !r.Until.Equal(day(2026, 10, 11)) {
+		t.Errorf("Resolve = {%s %s %s}", r.Name, r.Since, r.Until)
+	}
+	if !r.MultiDay() {
+		t.Error("10-day range should be multi-day")
+	}
+
+	// --since만 주면 지금까지
+	r, err = local.Resolve("week", "2 hours ago", "", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Since.Equal(now.Add(-2*time.Hour)) || !r.Until.Equal(now) {
+		t.Errorf("Resolve since-only = {%s %s}", r.Since, r.Until)
+	}
+
+	// 하루짜리 기간
+	r, _ = local.Resolve("yesterday", "", "", now)
+	if r.MultiDay() {
+		t.Error("yesterday should not be multi-day")
+	}
+
+	// 시작이 끝보다 늦으면 에러
+	if _, err := local.Resolve("today", "2026-10-10", "2026-10-01", now); err == nil {
+		t.Error("expected error for inverted range")
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		expr  string
+		isEnd bool
+		want  time.Time
+	}{
+		{"2026-10-01", false, day(2026, 10, 1)},
+		{"2026-10-01", true, day(2026, 10, 2)},
+		{"2026-10-01 09:15", true, day(2026, 10, 1).Add(9*time.Hour + 15*time.Minute)},
+		{"yesterday", false, day(2026, 10, 14)},
+		{"1 week ago", false, now.AddDate(0, 0, -7)},
+		{"5 hours ago", false, now.Add(-5 * time.Hour)},
+		{"now", true, now},
+	}
+
+	for _, tt := range tests {
+		got, err := local.ParseTime(tt.expr, now, tt.isEnd)
+		if err != nil {
+			t.Errorf("ParseTime(%q): %v", tt.expr, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%q, %v) = %s, want %s", tt.expr, tt.isEnd, got, tt.want)
+		}
+	}
+
+	if _, err := local.ParseTime("someday", now, false); err == nil {
+		t.Error("expected error for unknown expression")
+	}
+}
+
+func TestCalendar_DayStart(t *testing.T) {
+	cal, err := NewCalendar("Asia/Seoul", "04:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kst := cal.Location
+	at := func(d, h, m int) time.Time { return time.Date(2026, 10, d, h, m, 0, 0, kst) }
+
+	// 새벽 1시는 아직 전날 (15일 04:00 시작)
+	if got := cal.StartOfDay(at(16, 1, 0)); !got.Equal(at(15, 4, 0)) {
+		t.Errorf("StartOfDay(01:00) = %s, want 10-15 04:00", got)
+	}
+	// 04:00부터는 당일
+	if got := cal.StartOfDay(at(16, 4, 0)); !got.Equal(at(16, 4, 0)) {
+		t.Errorf("StartOfDay(04:00) = %s, want 10-16 04:00", got)
+	}
+
+	// 16일 01:00에 today를 물으면 15일 04:00부터, 날짜 표시는 15일 하루
+	r, err := cal.Parse("today", at(16, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Since.Equal(at(15, 4, 0)) {
+		t.Errorf("today.Since = %s, want 10-15 04:00", r.Since)
+	}
+	if r.FirstDay().Day() != 15 || r.MultiDay() {
+		t.Errorf("today should be the single logical day 10-15, got %s ~ %s", r.FirstDay(), r.LastDay())
+	}
+
+	// yesterday는 [14일 04:00, 15일 04:00)이며 하루로 표시된다
+	r, _ = cal.Parse("yesterday", at(16, 1, 0))
+	if !r.Since.Equal(at(14, 4, 0)) || !r.Until.Equal(at(15, 4, 0)) || r.MultiDay() {
+		t.Errorf("yesterday = {%s %s}", r.Since, r.Until)
+	}
+
+	// 날짜 지정도 하루 시작 시각 기준
+	until, _ := cal.ParseTime("2026-10-10", at(16, 12, 0), true)
+	if !until.Equal(at(11, 4, 0)) {
+		t.Errorf("ParseTime(until 2026-10-10) = %s, want 10-11 04:00", until)
+	}
+
+	// 다른 타임존의 현재 시각도 달력 타임존으로 변환된다
+	utcNow := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC) // = 16일 05:00 KST
+	r, _ = cal.Parse("today", utcNow)
+	if !r.Since.Equal(at(16, 4, 0)) {
+		t.Errorf("today from UTC now = %s, want 10-16 04:00 KST", r.Since)
+	}
+}
+
+func TestNewCalendar_Invalid(t *testing.T) {
+	if _, err := NewCalendar("Mars/Olympus", ""); err == nil {
+		t.Error("expected error for unknown timezone")
+	}
+	if _, err := NewCalendar("", "4am"); err == nil {
+		t.Error("expected error for bad day_start")
+	}
+	cal, err := NewCalendar("", "")
+	if err != nil || cal.Location != time.Local || cal.DayStart != 0 {
+		t.Errorf("empty config should be local midnight, got %+v (%v)", cal, err)
+	}
+}
@@ -0,0 +1,85 @@
+package period
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kso1204/gitday/internal/i18n"
+)
+
+// Standup은 standup 명령이 쓰는 기간 이름이다.
+const Standup = "standup"
+
+// WorkWeek는 근무 요일과 휴일 목록이다. 스탠드업 기간 계산에 쓴다.
+type WorkWeek struct {
+	Calendar Calendar
+	Days     map[time.Weekday]bool
+	Holidays map[string]bool // "2006-01-02"
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// NewWorkWeek는 설정 값으로 WorkWeek를 만든다.
+// days는 "mon", "Tuesday"처럼 영문 요일(앞 3글자로 판별)이며 비어 있으면 월~금이다.
+// holidays는 "2006-01-02" 형식의 날짜 목록이다.
+func NewWorkWeek(cal Calendar, days, holidays []string) (WorkWeek, error) {
+	w := WorkWeek{
+		Calendar: cal,
+		Days:     make(map[time.Weekday]bool),
+		Holidays: make(map[string]bool),
+	}
+
+	if len(days) == 0 {
+		days = []string{"mon", "tue", "wed", "thu", "fri"}
+	}
+	for _, d := range days {
+		key := strings.ToLower(strings.TrimSpace(d))
+		if len(key) > 3 {
+			key = key[:3]
+		}
+		wd, ok := weekdayNames[key]
+		if !ok {
+			return WorkWeek{}, i18n.Errorf("err.weekday", d)
+		}
+		w.Days[wd] = true
+	}
+
+	for _, h := range holidays {
+		h = strings.TrimSpace(h)
+		if _, err := time.Parse("2006-01-02", h); err != nil {
+			return WorkWeek{}, i18n.Errorf("err.holiday", h)
+		}
+		w.Holidays[h] = true
+	}
+
+	return w, nil
+}
+
+// IsWorkday는 t가 속한 논리적 하루가 근무일인지 여부이다.
+func (w WorkWeek) IsWorkday(t time.Time) bool {
+	day := w.Calendar.StartOfDay(t)
+	return w.Days[day.Weekday()] && !w.Holidays[day.Format("2006-01-02")]
+}
+
+// PreviousWorkday는 now 이전의 가장 가까운 근무일의 하루 시작 시각을 반환한다.
+// 1년 안에 근무일이 없으면(설정 오류) 어제를 반환한다.
+func (w WorkWeek) PreviousWorkday(now time.Time) time.Time {
+	today := w.Calendar.StartOfDay(now)
+	for i := 1; i <= 366; i++ {
+		prev := w.Calendar.at(today.AddDate(0, 0, -i))
+		if w.IsWorkday(prev) {
+			return prev
+		}
+	}
+	return w.Calendar.at(today.AddDate(0, 0, -1))
+}
+
+// StandupRange는 직전 근무일 시작부터 지금까지의 기간이다.
+// 월요일 아침이면 금요일부터, 휴일 다음 날이면 휴일 전 근무일부터가 된다.
+func (w WorkWeek) StandupRange(now time.Time) Range {
+	now = w.Calendar.In(now)
+	return Range{Name: Standup, Since: w.PreviousWorkday(now), Until: now, DayStart: w.Calendar.DayStart}
+}
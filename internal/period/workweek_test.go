@@ -0,0 +1,55 @@
+package period
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewWorkWeek(t *testing.T) {
+	w, err := NewWorkWeek(local, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(w.Days) != 5 || w.Days[time.Saturday] || !w.Days[time.Monday] {
+		t.Errorf("default work days = %v, want mon-fri", w.Days)
+	}
+
+	w, err = NewWorkWeek(local, []string{"Sunday", "mon", "TUE"}, []string{"2026-10-09"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !w.Days[time.Sunday] || !w.Days[time.Tuesday] || w.Days[time.Friday] {
+		t.Errorf("custom work days = %v", w.Days)
+	}
+
+	if _, err := NewWorkWeek(local, []string{"someday"}, nil); err == nil {
+		t.Error("expected error for unknown weekday")
+	}
+	if _, err := NewWorkWeek(local, nil, []string{"10/09"}); err == nil {
+		t.Error("expected error for bad holiday format")
+	}
+}
+
+func TestStandupRange(t *testing.T) {
+	w, _ := NewWorkWeek(local, nil, []string{"2026-10-09"}) // 2026-10-09 (금) 한글날
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		// 목요일 아침 → 수요일부터
+		{"weekday", time.Date(2026, 10, 15, 9, 0, 0, 0, now.Location()), day(2026, 10, 14)},
+		// 월요일 아침 → 금요일부터
+		{"monday", time.Date(2026, 10, 19, 9, 0, 0, 0, now.Location()), day(2026, 10, 16)},
+		// 연휴 다음 월요일 → 휴일(금) 건너뛰고 목요일부터
+		{"after holiday", time.Date(2026, 10, 12, 9, 0, 0, 0, now.Location()), day(2026, 10, 8)},
+	}
+
+	for _, tt := range tests {
+		r := w.StandupRange(tt.now)
+		if r.Name != Standup || !r.Since.Equal(tt.want) || !r.Until.Equal(tt.now) {
+			t.Errorf("%s: StandupRange = {%s %s}, want since %s", tt.name, r.Since, r.Until, tt.want)
+		}
+	}
+}
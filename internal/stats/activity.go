@@ -0,0 +1,63 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// Activity는 커밋이 언제 일어났는지에 대한 통계이다.
+// 시각은 달력의 타임존 기준이고, 요일과 공백 계산의 하루는 day_start 기준의 논리적 날짜이다.
+type Activity struct {
+	Commits    int
+	ByHour     [24]int // 시(0~23)별 커밋 수
+	ByWeekday  [7]int  // 요일별 커밋 수 (time.Weekday 순서, 일요일 = 0)
+	First      time.Time
+	Last       time.Time
+	LongestGap Gap // 같은 날 안에서 이어진 두 커밋 사이의 가장 긴 공백
+}
+
+// Gap은 연속한 두 커밋 사이의 구간이다.
+type Gap struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration은 공백의 길이이다.
+func (g Gap) Duration() time.Duration {
+	return g.End.Sub(g.Start)
+}
+
+// NewActivity는 수집 결과의 모든 커밋으로 활동 통계를 만든다.
+// 최장 공백은 밤사이 공백이 항상 가장 길게 잡히지 않도록 같은 논리적 날짜 안에서만 잰다.
+func NewActivity(results []git.RepoResult, cal period.Calendar) Activity {
+	var a Activity
+	var times []time.Time
+	for _, r := range results {
+		for _, c := range r.Commits {
+			t := cal.In(c.Date)
+			times = append(times, t)
+			a.ByHour[t.Hour()]++
+			a.ByWeekday[cal.StartOfDay(t).Weekday()]++
+		}
+	}
+	if len(times) == 0 {
+		return a
+	}
+
+	sortTimes(times)
+	a.Commits = len(times)
+	a.First, a.Last = times[0], times[len(times)-1]
+
+	for i := 1; i < len(times); i++ {
+		prev, cur := times[i-1], times[i]
+		if !cal.StartOfDay(prev).Equal(cal.StartOfDay(cur)) {
+			continue
+		}
+		if gap := (Gap{Start: prev, End: cur}); gap.Duration() > a.LongestGap.Duration() {
+			a.LongestGap = gap
+		}
+	}
+	return a
+}
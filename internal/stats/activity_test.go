@@ -0,0 +1,49 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+func TestNewActivity(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	at := func(d, h, m int) time.Time { return time.Date(2026, 10, d, h, m, 0, 0, kst) }
+	cal := period.Calendar{Location: kst, DayStart: 4 * time.Hour}
+
+	results := []git.RepoResult{
+		{Name: "a", Commits: []git.Commit{{Date: at(14, 9, 10)}, {Date: at(14, 16, 40)}}},
+		// 다른 레포, 타임존이 다른 커밋도 달력 타임존으로 맞춘다 (01:30 UTC = 10:30 KST)
+		{Name: "b", Commits: []git.Commit{{Date: time.Date(2026, 10, 14, 1, 30, 0, 0, time.UTC)}}},
+		// 15일 02:00은 day_start 04:00 기준으로 14일(수요일)에 속한다
+		{Name: "c", Commits: []git.Commit{{Date: at(15, 2, 0)}, {Date: at(15, 9, 0)}}},
+	}
+
+	a := NewActivity(results, cal)
+
+	if a.Commits != 5 {
+		t.Errorf("commits = %d", a.Commits)
+	}
+	if a.ByHour[9] != 2 || a.ByHour[10] != 1 || a.ByHour[2] != 1 {
+		t.Errorf("by hour = %v", a.ByHour)
+	}
+	if a.ByWeekday[time.Wednesday] != 4 || a.ByWeekday[time.Thursday] != 1 {
+		t.Errorf("by weekday = %v", a.ByWeekday)
+	}
+	if !a.First.Equal(at(14, 9, 10)) || !a.Last.Equal(at(15, 9, 0)) {
+		t.Errorf("first/last = %s / %s", a.First, a.Last)
+	}
+	// 14일: 09:10 → 10:30 → 16:40 → (15일) 02:00, 15일 09:00은 다음 날이라 제외
+	if !a.LongestGap.Start.Equal(at(14, 16, 40)) || a.LongestGap.Duration() != 9*time.Hour+20*time.Minute {
+		t.Errorf("longest gap = %s ~ %s", a.LongestGap.Start, a.LongestGap.End)
+	}
+}
+
+func TestNewActivityEmpty(t *testing.T) {
+	a := NewActivity(nil, period.Calendar{})
+	if a.Commits != 0 || !a.First.IsZero() || a.LongestGap.Duration() != 0 {
+		t.Errorf("empty activity = %+v", a)
+	}
+}
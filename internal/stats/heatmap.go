@@ -0,0 +1,98 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// HeatmapLevels는 히트맵 색 단계 수이다 (0 = 커밋 없음, 1~4 = 적음~많음).
+const HeatmapLevels = 5
+
+// HeatmapDay는 히트맵의 하루 칸이다.
+type HeatmapDay struct {
+	Date    time.Time // 논리적 날짜 (00:00)
+	Commits int
+	InRange bool // false면 주 단위로 맞추느라 채운 기간 밖의 칸이다
+}
+
+// Heatmap은 기간 안의 날짜별 커밋 수를 주(월~일) 단위로 정리한 것이다.
+type Heatmap struct {
+	Days []HeatmapDay // 첫 주 월요일부터 마지막 주 일요일까지 하루씩
+
+	Total         int       // 전체 커밋 수
+	ActiveDays    int       // 커밋이 있는 날 수
+	Max           int       // 하루 최대 커밋 수
+	BusiestDay    time.Time // 커밋이 가장 많은 날
+	LongestStreak int       // 커밋이 있는 날이 연속된 최대 일수
+}
+
+// NewHeatmap은 수집 결과로 rng 기간의 히트맵을 만든다.
+// 날짜는 기간의 타임존/day_start 기준이며, 모든 레포의 커밋을 합산한다.
+func NewHeatmap(results []git.RepoResult, rng period.Range) Heatmap {
+	cal := rng.Calendar()
+	counts := make(map[time.Time]int)
+	for _, r := range results {
+		for _, c := range r.Commits {
+			counts[dateOnly(cal.StartOfDay(c.Date))]++
+		}
+	}
+
+	first, last := rng.FirstDay(), rng.LastDay()
+	start := first.AddDate(0, 0, -daysSinceMonday(first))
+	end := last.AddDate(0, 0, 6-daysSinceMonday(last))
+
+	var h Heatmap
+	streak := 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		day := HeatmapDay{Date: d, InRange: !d.Before(first) && !d.After(last)}
+		if day.InRange {
+			day.Commits = counts[d]
+		}
+		h.Days = append(h.Days, day)
+
+		if !day.InRange {
+			continue
+		}
+		h.Total += day.Commits
+		if day.Commits == 0 {
+			streak = 0
+			continue
+		}
+		h.ActiveDays++
+		streak++
+		h.LongestStreak = max(h.LongestStreak, streak)
+		if day.Commits > h.Max {
+			h.Max, h.BusiestDay = day.Commits, d
+		}
+	}
+	return h
+}
+
+// Weeks는 히트맵을 주 단위(월~일 7칸)로 나눈다.
+func (h Heatmap) Weeks() [][]HeatmapDay {
+	var weeks [][]HeatmapDay
+	for i := 0; i+7 <= len(h.Days); i += 7 {
+		weeks = append(weeks, h.Days[i:i+7])
+	}
+	return weeks
+}
+
+// Level은 커밋 수를 0~4 단계로 나눈다. 하루 최대 커밋 수(Max)를 4등분한 구간이다.
+func (h Heatmap) Level(commits int) int {
+	if commits <= 0 || h.Max == 0 {
+		return 0
+	}
+	level := (commits*(HeatmapLevels-1) + h.Max - 1) / h.Max
+	return min(max(level, 1), HeatmapLevels-1)
+}
+
+// daysSinceMonday는 월요일부터 지난 일수이다 (월 = 0, 일 = 6).
+func daysSinceMonday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,54 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+func TestNewHeatmap(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	at := func(d, h int) time.Time { return time.Date(2026, 10, d, h, 0, 0, 0, kst) }
+
+	// 2026-10-07(수) ~ 2026-10-15(목)
+	rng := period.Range{Since: at(7, 0), Until: at(15, 18)}
+	results := []git.RepoResult{
+		{Name: "a", Commits: []git.Commit{{Date: at(7, 10)}, {Date: at(8, 10)}, {Date: at(8, 11)}, {Date: at(9, 23)}}},
+		{Name: "b", Commits: []git.Commit{{Date: at(8, 15)}, {Date: at(14, 9)}}},
+	}
+
+	h := NewHeatmap(results, rng)
+
+	// 10-05(월) ~ 10-18(일) 2주
+	weeks := h.Weeks()
+	if len(weeks) != 2 || len(h.Days) != 14 {
+		t.Fatalf("weeks = %d, days = %d", len(weeks), len(h.Days))
+	}
+	if h.Days[0].Date.Weekday() != time.Monday || h.Days[0].InRange {
+		t.Errorf("first cell = %+v, want Monday outside range", h.Days[0])
+	}
+	if h.Days[2].Commits != 1 || h.Days[3].Commits != 3 || !h.Days[3].InRange {
+		t.Errorf("10-07 = %+v, 10-08 = %+v", h.Days[2], h.Days[3])
+	}
+
+	if h.Total != 6 || h.ActiveDays != 4 || h.Max != 3 || h.LongestStreak != 3 {
+		t.Errorf("total=%d active=%d max=%d streak=%d", h.Total, h.ActiveDays, h.Max, h.LongestStreak)
+	}
+	if h.BusiestDay.Day() != 8 {
+		t.Errorf("busiest = %s", h.BusiestDay)
+	}
+}
+
+func TestHeatmapLevel(t *testing.T) {
+	h := Heatmap{Max: 8}
+	for commits, want := range map[int]int{0: 0, 1: 1, 2: 1, 3: 2, 4: 2, 6: 3, 7: 4, 8: 4} {
+		if got := h.Level(commits); got != want {
+			t.Errorf("Level(%d) = %d, want %d", commits, got, want)
+		}
+	}
+	if got := (Heatmap{}).Level(3); got != 0 {
+		t.Errorf("empty heatmap Level = %d", got)
+	}
+}
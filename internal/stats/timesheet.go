@@ -0,0 +1,111 @@
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// 작업 시간 추정 기본값
+const (
+	DefaultMaxGap      = 2 * time.Hour    // 이보다 긴 커밋 간격은 새 작업 세션으로 본다
+	DefaultFirstCommit = 30 * time.Minute // 세션 첫 커밋 전에 작업했다고 보는 시간
+)
+
+// TimesheetOptions는 커밋 시각으로 작업 시간을 추정할 때 쓰는 값이다.
+type TimesheetOptions struct {
+	MaxGap      time.Duration // 0이면 DefaultMaxGap
+	FirstCommit time.Duration // 0이면 DefaultFirstCommit
+}
+
+// TimesheetRow는 레포 하나의 하루치 작업 기록이다.
+type TimesheetRow struct {
+	Date       time.Time // 논리적 날짜 (하루 시작 시각)
+	Repo       string
+	Path       string
+	Commits    int
+	Files      int
+	Insertions int
+	Deletions  int
+	First      time.Time // 그날 첫 커밋
+	Last       time.Time // 그날 마지막 커밋
+	Worked     time.Duration
+}
+
+// Timesheet는 커밋을 레포 × 날짜별로 묶어 작업 기록을 만든다.
+// 날짜는 cal(타임존, day_start) 기준으로 나누며, 결과는 날짜 → 레포 이름 순이다.
+func Timesheet(results []git.RepoResult, cal period.Calendar, opts TimesheetOptions) []TimesheetRow {
+	var rows []TimesheetRow
+
+	for _, r := range results {
+		byDay := make(map[time.Time][]git.Commit)
+		for _, c := range r.Commits {
+			day := cal.StartOfDay(c.Date)
+			byDay[day] = append(byDay[day], c)
+		}
+
+		for day, commits := range byDay {
+			row := TimesheetRow{Date: day, Repo: r.Name, Path: r.Path, Commits: len(commits)}
+			times := make([]time.Time, len(commits))
+			for i, c := range commits {
+				times[i] = cal.In(c.Date)
+				row.Files += c.Files
+				row.Insertions += c.Insertions
+				row.Deletions += c.Deletions
+			}
+			sortTimes(times)
+			row.First = times[0]
+			row.Last = times[len(times)-1]
+			row.Worked = EstimateWorked(times, opts)
+			rows = append(rows, row)
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].Date.Equal(rows[j].Date) {
+			return rows[i].Date.Before(rows[j].Date)
+		}
+		if rows[i].Repo != rows[j].Repo {
+			return rows[i].Repo < rows[j].Repo
+		}
+		return rows[i].Path < rows[j].Path
+	})
+	return rows
+}
+
+// EstimateWorked는 커밋 시각 목록으로 작업 시간을 추정한다.
+// 간격이 MaxGap 이하인 커밋은 이어서 작업한 것으로 보고 간격을 더하고,
+// 새 세션이 시작될 때마다(첫 커밋 포함) FirstCommit만큼을 더한다.
+func EstimateWorked(times []time.Time, opts TimesheetOptions) time.Duration {
+	if len(times) == 0 {
+		return 0
+	}
+	maxGap := opts.MaxGap
+	if maxGap <= 0 {
+		maxGap = DefaultMaxGap
+	}
+	first := opts.FirstCommit
+	if first <= 0 {
+		first = DefaultFirstCommit
+	}
+
+	sorted := append([]time.Time(nil), times...)
+	sortTimes(sorted)
+
+	worked := first
+	for i := 1; i < len(sorted); i++ {
+		gap := sorted[i].Sub(sorted[i-1])
+		if gap <= maxGap {
+			worked += gap
+		} else {
+			worked += first
+		}
+	}
+	return worked
+}
+
+func sortTimes(times []time.Time) {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+}
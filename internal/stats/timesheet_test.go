@@ -0,0 +1,89 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+var kst = time.FixedZone("KST", 9*60*60)
+
+func at(day, hour, min int) time.Time {
+	return time.Date(2026, 10, day, hour, min, 0, 0, kst)
+}
+
+func TestEstimateWorked(t *testing.T) {
+	opts := TimesheetOptions{}
+
+	tests := []struct {
+		name  string
+		times []time.Time
+		want  time.Duration
+	}{
+		{"none", nil, 0},
+		{"single", []time.Time{at(15, 10, 0)}, 30 * time.Minute},
+		// 10:00 → 10:40 → 11:30: 30분 + 40분 + 50분
+		{"one session", []time.Time{at(15, 11, 30), at(15, 10, 0), at(15, 10, 40)}, 2 * time.Hour},
+		// 10:00, 15:00 (5시간 간격 → 새 세션): 30분 + 30분
+		{"two sessions", []time.Time{at(15, 10, 0), at(15, 15, 0)}, time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := EstimateWorked(tt.times, opts); got != tt.want {
+			t.Errorf("%s: EstimateWorked = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	custom := TimesheetOptions{MaxGap: 6 * time.Hour, FirstCommit: 15 * time.Minute}
+	if got := EstimateWorked([]time.Time{at(15, 10, 0), at(15, 15, 0)}, custom); got != 5*time.Hour+15*time.Minute {
+		t.Errorf("custom options: EstimateWorked = %s", got)
+	}
+}
+
+func TestTimesheet(t *testing.T) {
+	results := []git.RepoResult{
+		{Name: "rpg", Path: "/w/rpg", Commits: []git.Commit{
+			{Date: at(16, 1, 30), Insertions: 5},   // day_start 04:00 → 15일 작업
+			{Date: at(15, 23, 0), Insertions: 10},  // 15일
+			{Date: at(16, 10, 0), Insertions: 100}, // 16일
+		}},
+		{Name: "api", Path: "/w/api", Commits: []git.Commit{
+			{Date: at(15, 9, 0), Deletions: 3, Files: 1},
+		}},
+	}
+
+	cal := period.Calendar{Location: kst, DayStart: 4 * time.Hour}
+	rows := Timesheet(results, cal, TimesheetOptions{MaxGap: 3 * time.Hour})
+
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d: %+v", len(rows), rows)
+	}
+
+	// 15일: api, rpg / 16일: rpg
+	want := []struct {
+		day     int
+		repo    string
+		commits int
+	}{{15, "api", 1}, {15, "rpg", 2}, {16, "rpg", 1}}
+	for i, w := range want {
+		r := rows[i]
+		if r.Date.Day() != w.day || r.Repo != w.repo || r.Commits != w.commits {
+			t.Errorf("rows[%d] = (%d, %s, %d), want (%d, %s, %d)", i,
+				r.Date.Day(), r.Repo, r.Commits, w.day, w.repo, w.commits)
+		}
+	}
+
+	night := rows[1]
+	if !night.First.Equal(at(15, 23, 0)) || !night.Last.Equal(at(16, 1, 30)) {
+		t.Errorf("first/last = %s / %s", night.First, night.Last)
+	}
+	if night.Insertions != 15 {
+		t.Errorf("insertions = %d, want 15", night.Insertions)
+	}
+	// 30분 + 2시간 30분
+	if night.Worked != 3*time.Hour {
+		t.Errorf("worked = %s, want 3h", night.Worked)
+	}
+}
@@ -0,0 +1,357 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// summaryTimeout은 AI 요약 요청 제한 시간이다.
+const summaryTimeout = 60 * time.Second
+
+// 입력란 종류
+type inputKind int
+
+const (
+	inputNone inputKind = iota
+	inputPeriod
+	inputAuthor
+	inputExport
+)
+
+// 펼치기/접기 동작
+type foldAction int
+
+const (
+	foldToggle foldAction = iota
+	foldOpen
+	foldClose
+)
+
+// row는 목록의 한 줄(레포 헤더 또는 커밋)이다. commit이 -1이면 레포 헤더이다.
+type row struct {
+	repo   int
+	commit int
+}
+
+type model struct {
+	cfg    Config
+	styles styles
+	period string
+	since  string // --since/--until. 기간을 p로 바꾸면 비운다
+	until  string
+	author string
+
+	report  Report
+	loaded  bool
+	loading bool
+	loadSeq int // 늦게 도착한 이전 요청의 결과를 버리기 위한 번호
+
+	cursor    int
+	offset    int
+	expanded  map[string]bool // 파일 목록을 펼친 커밋 (레포 경로 + 해시)
+	collapsed map[string]bool // 커밋 목록을 접은 레포 (레포 경로)
+
+	input      inputKind
+	inputValue []rune
+
+	summary     string
+	summarizing bool
+	showSummary bool
+
+	status string
+	err    error
+
+	width  int
+	height int
+}
+
+type loadedMsg struct {
+	seq    int
+	report Report
+	err    error
+}
+
+type summaryMsg struct {
+	text string
+	err  error
+}
+
+type exportedMsg struct {
+	path string
+	err  error
+}
+
+func newModel(cfg Config) model {
+	return model{
+		cfg:       cfg,
+		styles:    newStyles(cfg.Color),
+		period:    cfg.Period,
+		since:     cfg.Since,
+		until:     cfg.Until,
+		author:    cfg.Author,
+		expanded:  make(map[string]bool),
+		collapsed: make(map[string]bool),
+		loading:   true,
+		width:     80,
+		height:    24,
+	}
+}
+
+// Init은 첫 로드를 시작한다. 모델을 바꿀 수 없으므로 newModel이 loading을 미리 켜 둔다.
+func (m model) Init() tea.Cmd {
+	return m.load()
+}
+
+// periodLabel은 헤더에 보여줄 기간이다. --since/--until이 있으면 "2026-10-01~2026-10-10"처럼 쓴다.
+func (m model) periodLabel() string {
+	if m.since != "" || m.until != "" {
+		return m.since + "~" + m.until
+	}
+	return m.period
+}
+
+// reload는 현재 기간/작성자로 다시 불러온다. 이전 요청의 결과는 도착해도 버린다.
+func (m *model) reload() tea.Cmd {
+	m.loadSeq++
+	m.loading = true
+	return m.load()
+}
+
+func (m model) load() tea.Cmd {
+	seq, periodExpr, since, until, author, load := m.loadSeq, m.period, m.since, m.until, m.author, m.cfg.Load
+	return func() tea.Msg {
+		report, err := load(periodExpr, since, until, author)
+		return loadedMsg{seq: seq, report: report, err: err}
+	}
+}
+
+func (m *model) summarize() tea.Cmd {
+	m.summarizing = true
+	m.status = ""
+	report, fn := m.report, m.cfg.Summarize
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), summaryTimeout)
+		defer cancel()
+		text, err := fn(ctx, report)
+		return summaryMsg{text: text, err: err}
+	}
+}
+
+func (m model) export(path string) tea.Cmd {
+	report, summary, fn := m.report, m.summary, m.cfg.Export
+	return func() tea.Msg {
+		return exportedMsg{path: path, err: fn(report, summary, path)}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.scrollToCursor()
+		return m, nil
+
+	case loadedMsg:
+		if msg.seq != m.loadSeq {
+			return m, nil
+		}
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.err = nil
+		m.report = msg.report
+		m.loaded = true
+		m.summary, m.showSummary = "", false
+		m.cursor, m.offset = 0, 0
+		return m, nil
+
+	case summaryMsg:
+		m.summarizing = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.summary, m.showSummary = msg.text, true
+		return m, nil
+
+	case exportedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.err = nil
+		m.status = msg.path
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.input != inputNone {
+			return m.updateInput(msg)
+		}
+		return m.updateKey(msg)
+	}
+	return m, nil
+}
+
+func (m model) updateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	rows := m.rows()
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.showSummary = false
+		m.err, m.status = nil, ""
+	case "up", "k":
+		m.moveCursor(-1, rows)
+	case "down", "j":
+		m.moveCursor(1, rows)
+	case "pgup", "ctrl+b":
+		m.moveCursor(-m.listHeight(), rows)
+	case "pgdown", "ctrl+f", " ":
+		m.moveCursor(m.listHeight(), rows)
+	case "home", "g":
+		m.moveCursor(-len(rows), rows)
+	case "end", "G":
+		m.moveCursor(len(rows), rows)
+	case "enter", "tab":
+		m.fold(rows, foldToggle)
+	case "right", "l":
+		m.fold(rows, foldOpen)
+	case "left", "h":
+		m.fold(rows, foldClose)
+	case "p":
+		m.startInput(inputPeriod, m.period)
+	case "a":
+		m.startInput(inputAuthor, m.author)
+	case "e":
+		if m.loaded && m.cfg.Export != nil {
+			path := ""
+			if m.cfg.ExportPath != nil {
+				path = m.cfg.ExportPath(m.report)
+			}
+			m.startInput(inputExport, path)
+		}
+	case "r":
+		return m, m.reload()
+	case "s":
+		switch {
+		case m.summarizing || !m.loaded || m.cfg.Summarize == nil:
+		case m.summary != "":
+			m.showSummary = !m.showSummary
+		case len(m.report.Results) > 0:
+			return m, m.summarize()
+		}
+	case "S":
+		if !m.summarizing && m.loaded && m.cfg.Summarize != nil && len(m.report.Results) > 0 {
+			return m, m.summarize()
+		}
+	}
+	return m, nil
+}
+
+func (m model) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.input = inputNone
+	case tea.KeyEnter:
+		kind, value := m.input, string(m.inputValue)
+		m.input = inputNone
+		switch kind {
+		case inputPeriod:
+			m.period = value
+			m.since, m.until = "", ""
+			return m, m.reload()
+		case inputAuthor:
+			m.author = value
+			return m, m.reload()
+		case inputExport:
+			if value != "" {
+				return m, m.export(value)
+			}
+		}
+	case tea.KeyBackspace:
+		if len(m.inputValue) > 0 {
+			m.inputValue = m.inputValue[:len(m.inputValue)-1]
+		}
+	case tea.KeyCtrlU:
+		m.inputValue = nil
+	case tea.KeyRunes, tea.KeySpace:
+		m.inputValue = append(m.inputValue, msg.Runes...)
+	}
+	return m, nil
+}
+
+func (m *model) startInput(kind inputKind, value string) {
+	m.input = kind
+	m.inputValue = []rune(value)
+	m.err, m.status = nil, ""
+}
+
+// rows는 접힌 레포를 반영한 목록 줄이다.
+func (m model) rows() []row {
+	var rows []row
+	for i, r := range m.report.Results {
+		rows = append(rows, row{repo: i, commit: -1})
+		if m.collapsed[r.Path] {
+			continue
+		}
+		for j := range r.Commits {
+			rows = append(rows, row{repo: i, commit: j})
+		}
+	}
+	return rows
+}
+
+func (m *model) moveCursor(delta int, rows []row) {
+	m.cursor = min(max(m.cursor+delta, 0), max(len(rows)-1, 0))
+	m.scrollToCursor()
+}
+
+// fold는 커서 줄을 펼치거나 접는다. 커밋은 파일 목록을, 레포 헤더는 커밋 목록을 대상으로 한다.
+// 펼쳐지지 않은 커밋에서 접기를 누르면 그 레포 헤더로 올라간다.
+func (m *model) fold(rows []row, action foldAction) {
+	if m.cursor >= len(rows) {
+		return
+	}
+	cur := rows[m.cursor]
+	repo := m.report.Results[cur.repo]
+
+	if cur.commit < 0 {
+		switch action {
+		case foldToggle:
+			m.collapsed[repo.Path] = !m.collapsed[repo.Path]
+		case foldOpen:
+			m.collapsed[repo.Path] = false
+		case foldClose:
+			m.collapsed[repo.Path] = true
+		}
+		m.scrollToCursor()
+		return
+	}
+
+	key := commitKey(repo.Path, repo.Commits[cur.commit].Hash)
+	switch {
+	case action == foldToggle:
+		m.expanded[key] = !m.expanded[key]
+	case action == foldOpen:
+		m.expanded[key] = true
+	case m.expanded[key]:
+		m.expanded[key] = false
+	default:
+		for i := m.cursor; i >= 0; i-- {
+			if rows[i].commit < 0 {
+				m.cursor = i
+				break
+			}
+		}
+	}
+	m.scrollToCursor()
+}
+
+func commitKey(repoPath, hash string) string {
+	return repoPath + "\x00" + hash
+}
@@ -0,0 +1,209 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+func testReport() Report {
+	day := time.Date(2026, 2, 26, 0, 0, 0, 0, time.UTC)
+	return Report{
+		Range: period.Range{Name: period.Today, Since: day, Until: day.AddDate(0, 0, 1)},
+		Results: []git.RepoResult{
+			{Name: "rpg", Path: "/src/rpg", Commits: []git.Commit{
+				{Hash: "dbc7067", Message: "전투 로직 수정", Date: day.Add(10 * time.Hour), Files: 2, Insertions: 30, Deletions: 4,
+					Changes: []git.FileChange{{Path: "battle.go", Insertions: 20, Deletions: 4}, {Path: "battle_test.go", Insertions: 10}}},
+				{Hash: "a1b2c3d", Message: "README", Date: day.Add(11 * time.Hour), Files: 1, Insertions: 1},
+			}},
+			{Name: "api", Path: "/src/api", Commits: []git.Commit{
+				{Hash: "e4f5a6b", Message: "엔드포인트 추가", Date: day.Add(14 * time.Hour), Files: 1, Insertions: 5},
+			}},
+		},
+	}
+}
+
+// loadedModel은 Load 호출을 loads에 기록하는 모델을 만들고 첫 로드를 끝낸 상태로 돌려준다.
+func loadedModel(t *testing.T, loads *[]string) model {
+	t.Helper()
+	m := newModel(Config{
+		Period: "today",
+		Load: func(periodExpr, since, until, author string) (Report, error) {
+			*loads = append(*loads, periodExpr+"|"+author)
+			return testReport(), nil
+		},
+	})
+	return runCmd(t, m, m.Init())
+}
+
+// runCmd는 cmd를 실행해 나온 메시지를 모델에 전달한다.
+func runCmd(t *testing.T, m model, cmd tea.Cmd) model {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected a command")
+	}
+	next, _ := m.Update(cmd())
+	return next.(model)
+}
+
+func press(m model, keys ...string) model {
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "backspace":
+			msg = tea.KeyMsg{Type: tea.KeyBackspace}
+		case "ctrl+u":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlU}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		next, _ := m.Update(msg)
+		m = next.(model)
+	}
+	return m
+}
+
+func TestNavigation(t *testing.T) {
+	var loads []string
+	m := loadedModel(t, &loads)
+
+	if got := len(m.rows()); got != 5 {
+		t.Fatalf("rows = %d, want 5 (2 repos + 3 commits)", got)
+	}
+
+	m = press(m, "j", "j", "j", "j", "j", "j")
+	if m.cursor != 4 {
+		t.Errorf("cursor = %d, want 4 (clamped to last row)", m.cursor)
+	}
+	m = press(m, "g")
+	if m.cursor != 0 {
+		t.Errorf("cursor after g = %d, want 0", m.cursor)
+	}
+
+	// 레포 헤더에서 enter는 커밋 목록을 접는다
+	m = press(m, "enter")
+	if got := len(m.rows()); got != 3 {
+		t.Errorf("rows after collapsing rpg = %d, want 3", got)
+	}
+	m = press(m, "enter")
+	if got := len(m.rows()); got != 5 {
+		t.Errorf("rows after expanding rpg = %d, want 5", got)
+	}
+}
+
+func TestExpandCommit(t *testing.T) {
+	var loads []string
+	m := loadedModel(t, &loads)
+
+	m = press(m, "j", "enter")
+	view := m.View()
+	if !strings.Contains(view, "battle_test.go") || !strings.Contains(view, "+20 -4") {
+		t.Errorf("expanded commit should list its files:\n%s", view)
+	}
+
+	// 펼친 커밋에서 h는 파일 목록을 접고, 한 번 더 누르면 레포 헤더로 올라간다
+	m = press(m, "h")
+	if strings.Contains(m.View(), "battle_test.go") {
+		t.Error("h should collapse the file list")
+	}
+	m = press(m, "h")
+	if m.cursor != 0 {
+		t.Errorf("cursor = %d, want 0 (repo header)", m.cursor)
+	}
+}
+
+func TestScrollKeepsCursorVisible(t *testing.T) {
+	var loads []string
+	m := loadedModel(t, &loads)
+	next, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: headerLines + footerLines + 2})
+	m = next.(model)
+
+	m = press(m, "j", "enter", "j")
+	// 커서(두 번째 커밋) 앞에 레포 헤더 1줄 + 펼친 커밋 3줄이 있다
+	if m.offset != 3 {
+		t.Errorf("offset = %d, want 3", m.offset)
+	}
+	if !strings.Contains(m.View(), "README") {
+		t.Errorf("cursor row should be visible:\n%s", m.View())
+	}
+}
+
+func TestInputReloads(t *testing.T) {
+	var loads []string
+	m := loadedModel(t, &loads)
+
+	m = press(m, "p", "ctrl+u", "w", "e", "e", "k")
+	if m.input != inputPeriod || string(m.inputValue) != "week" {
+		t.Fatalf("input = %v %q, want period \"week\"", m.input, string(m.inputValue))
+	}
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = runCmd(t, next.(model), cmd)
+
+	next, cmd = press(m, "a", "k", "s", "o").Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = runCmd(t, next.(model), cmd)
+
+	want := []string{"today|", "week|", "week|kso"}
+	if strings.Join(loads, ",") != strings.Join(want, ",") {
+		t.Errorf("loads = %q, want %q", loads, want)
+	}
+
+	// esc는 입력을 취소하고 다시 불러오지 않는다
+	m = press(m, "p", "x", "esc")
+	if m.input != inputNone || m.period != "week" {
+		t.Errorf("esc should cancel input: input=%v period=%q", m.input, m.period)
+	}
+}
+
+// --since/--until은 작성자를 바꿔도 유지하고, 기간을 p로 바꾸면 버린다
+func TestSinceUntilKeptUntilPeriodChange(t *testing.T) {
+	var loads []string
+	m := newModel(Config{
+		Period: "today",
+		Since:  "2026-10-01",
+		Until:  "2026-10-10",
+		Load: func(periodExpr, since, until, author string) (Report, error) {
+			loads = append(loads, periodExpr+"|"+since+"|"+until+"|"+author)
+			return testReport(), nil
+		},
+	})
+	if !strings.Contains(m.View(), "2026-10-01~2026-10-10") {
+		t.Errorf("header should show the custom range:\n%s", m.View())
+	}
+	m = runCmd(t, m, m.Init())
+
+	next, cmd := press(m, "a", "k", "s", "o").Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = runCmd(t, next.(model), cmd)
+	next, cmd = press(m, "p", "ctrl+u", "w", "e", "e", "k").Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(t, next.(model), cmd)
+
+	want := []string{"today|2026-10-01|2026-10-10|", "today|2026-10-01|2026-10-10|kso", "week|||kso"}
+	if strings.Join(loads, ",") != strings.Join(want, ",") {
+		t.Errorf("loads = %q, want %q", loads, want)
+	}
+}
+
+func TestStaleLoadIgnored(t *testing.T) {
+	var loads []string
+	m := loadedModel(t, &loads)
+
+	stale := m.reload()
+	fresh := m.reload()
+	next, _ := m.Update(fresh())
+	m = next.(model)
+
+	msg := stale().(loadedMsg)
+	msg.report = Report{}
+	next, _ = m.Update(msg)
+	m = next.(model)
+	if len(m.report.Results) != 2 {
+		t.Error("stale load result should be ignored")
+	}
+}
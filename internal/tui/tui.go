@@ -0,0 +1,43 @@
+// Package tui는 기간/작성자를 바꿔 가며 레포별 커밋을 둘러보는 대화형 화면(gitday tui)이다.
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/period"
+)
+
+// Report는 한 번 불러온 조회 결과이다.
+type Report struct {
+	Range   period.Range
+	Results []git.RepoResult
+	Failed  []*git.RepoError
+}
+
+// Config는 TUI의 초기 값과, 데이터를 불러오고 요약/내보내기하는 함수이다.
+// 레포 스캔이나 AI 프로바이더 설정은 cmd가 알고 있으므로 함수로 주입받는다.
+type Config struct {
+	Period string // 초기 기간 표현식 (today, week, last-7d ...)
+	Since  string // --since. 기간을 p로 바꾸기 전까지 Period보다 우선한다
+	Until  string // --until. Since와 같다
+	Author string // 초기 작성자 필터 (쉼표로 구분, 빈 문자열 = 레포의 user.email, "*" = 전체)
+	Color  bool   // false면 색 없이 그린다 (output.color, --no-color, NO_COLOR)
+
+	// Load는 기간 표현식(since/until이 있으면 그 범위)과 작성자로 커밋을 수집한다.
+	// 파일별 변경 내역(Changes)까지 채워야 한다.
+	Load func(periodExpr, since, until, author string) (Report, error)
+	// Summarize는 조회 결과의 AI 요약을 만든다. 화면을 깨뜨리지 않도록 아무것도 출력하지 않아야 한다.
+	Summarize func(ctx context.Context, r Report) (string, error)
+	// Export는 조회 결과(와 요약)를 path에 저장한다.
+	Export func(r Report, summary, path string) error
+	// ExportPath는 내보내기 입력란의 기본 경로를 만든다.
+	ExportPath func(r Report) string
+}
+
+// Run은 TUI를 전체 화면으로 실행하고, 사용자가 종료할 때까지 기다린다.
+func Run(cfg Config) error {
+	_, err := tea.NewProgram(newModel(cfg), tea.WithAltScreen()).Run()
+	return err
+}
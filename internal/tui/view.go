@@ -0,0 +1,289 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/kso1204/gitday/internal/git"
+	"github.com/kso1204/gitday/internal/i18n"
+	"github.com/kso1204/gitday/internal/output"
+	"github.com/muesli/termenv"
+)
+
+// 화면 위/아래에 고정으로 쓰는 줄 수 (헤더 2줄, 상태줄 + 도움말)
+const (
+	headerLines = 2
+	footerLines = 2
+)
+
+// styles는 TUI 화면에 쓰는 스타일 묶음이다. 색상은 터미널 리포트(output)와 맞춘다.
+type styles struct {
+	title    lipgloss.Style
+	filter   lipgloss.Style
+	repo     lipgloss.Style
+	hash     lipgloss.Style
+	msg      lipgloss.Style
+	stat     lipgloss.Style
+	cursor   lipgloss.Style
+	summary  lipgloss.Style
+	help     lipgloss.Style
+	errorMsg lipgloss.Style
+}
+
+func newStyles(color bool) styles {
+	r := lipgloss.NewRenderer(os.Stdout)
+	if !color {
+		r.SetColorProfile(termenv.Ascii)
+	}
+	return styles{
+		title:    r.NewStyle().Bold(true).Foreground(lipgloss.Color("12")),
+		filter:   r.NewStyle().Foreground(lipgloss.Color("8")),
+		repo:     r.NewStyle().Bold(true).Foreground(lipgloss.Color("11")),
+		hash:     r.NewStyle().Foreground(lipgloss.Color("3")),
+		msg:      r.NewStyle().Foreground(lipgloss.Color("15")),
+		stat:     r.NewStyle().Foreground(lipgloss.Color("8")),
+		cursor:   r.NewStyle().Reverse(true),
+		summary:  r.NewStyle().Foreground(lipgloss.Color("14")),
+		help:     r.NewStyle().Foreground(lipgloss.Color("8")),
+		errorMsg: r.NewStyle().Bold(true).Foreground(lipgloss.Color("9")),
+	}
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	m.writeHeader(&b)
+
+	lines := m.listLines()
+	height := m.listHeight()
+	for i := m.offset; i < m.offset+height; i++ {
+		if i < len(lines) {
+			b.WriteString(lines[i])
+		}
+		b.WriteByte('\n')
+	}
+
+	for _, line := range m.summaryLines() {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+
+	b.WriteString(ansi.Truncate(m.statusLine(), m.width, "…"))
+	b.WriteByte('\n')
+	b.WriteString(ansi.Truncate(m.styles.help.Render(i18n.T("tui.help")), m.width, "…"))
+	return b.String()
+}
+
+func (m model) writeHeader(b *strings.Builder) {
+	st := m.styles
+	title := "gitday · " + m.periodLabel()
+	if m.loaded {
+		title = "gitday · " + output.PeriodTitle(m.report.Range)
+	}
+	author := m.author
+	if author == "" {
+		author = i18n.T("tui.author_default")
+	}
+	filter := i18n.T("tui.filter", m.periodLabel(), author)
+	if m.loaded {
+		commits, files, insertions, deletions := totals(m.report.Results)
+		filter += " · " + i18n.T("tui.totals", commits, len(m.report.Results), files, insertions, deletions)
+	}
+	b.WriteString(ansi.Truncate(st.title.Render(title), m.width, "…"))
+	b.WriteByte('\n')
+	b.WriteString(ansi.Truncate(st.filter.Render(filter), m.width, "…"))
+	b.WriteByte('\n')
+}
+
+// listLines는 목록 영역 전체를 줄 단위로 그린다. 펼친 커밋은 파일 줄이 뒤따른다.
+func (m model) listLines() []string {
+	st := m.styles
+	rows := m.rows()
+	if m.loaded && len(rows) == 0 {
+		return []string{"  " + st.filter.Render(i18n.T("tui.no_commits"))}
+	}
+
+	var lines []string
+	for i, r := range rows {
+		repo := m.report.Results[r.repo]
+		var line string
+		if r.commit < 0 {
+			line = m.repoLine(repo)
+		} else {
+			line = m.commitLine(repo.Commits[r.commit])
+		}
+		line = ansi.Truncate(line, m.width, "…")
+		if i == m.cursor {
+			line = st.cursor.Render(padRight(ansi.Strip(line), m.width))
+		}
+		lines = append(lines, line)
+
+		if r.commit >= 0 && m.expanded[commitKey(repo.Path, repo.Commits[r.commit].Hash)] {
+			for _, fc := range m.changeLines(repo.Commits[r.commit]) {
+				lines = append(lines, ansi.Truncate(fc, m.width, "…"))
+			}
+		}
+	}
+	return lines
+}
+
+func (m model) repoLine(r git.RepoResult) string {
+	st := m.styles
+	marker := "▾"
+	if m.collapsed[r.Path] {
+		marker = "▸"
+	}
+	name := r.Name
+	if label := r.Label(); label != "" {
+		name += " [" + label + "]"
+	}
+	commits, _, insertions, deletions := totals([]git.RepoResult{r})
+	return fmt.Sprintf("%s %s %s", marker, st.repo.Render(name),
+		st.stat.Render(fmt.Sprintf("(%d commits, +%d -%d)", commits, insertions, deletions)))
+}
+
+func (m model) commitLine(c git.Commit) string {
+	st := m.styles
+	layout := "15:04"
+	if m.report.Range.MultiDay() {
+		layout = "01-02 15:04"
+	}
+	hash := c.Hash
+	if len(hash) > 7 {
+		hash = hash[:7]
+	}
+	return fmt.Sprintf("    %s %s %s %s",
+		st.stat.Render(c.Date.In(m.report.Range.Since.Location()).Format(layout)),
+		st.hash.Render(hash), st.msg.Render(c.Message),
+		st.stat.Render(fmt.Sprintf("(%d files, +%d -%d)", c.Files, c.Insertions, c.Deletions)))
+}
+
+// changeLines는 펼친 커밋의 파일별 diff stat 줄이다.
+func (m model) changeLines(c git.Commit) []string {
+	st := m.styles
+	if len(c.Changes) == 0 {
+		return []string{"        " + st.stat.Render(i18n.T("tui.no_files"))}
+	}
+	lines := make([]string, len(c.Changes))
+	for i, fc := range c.Changes {
+		branch := "├─"
+		if i == len(c.Changes)-1 {
+			branch = "└─"
+		}
+		churn := "(binary)"
+		if !fc.Binary {
+			churn = fmt.Sprintf("+%d -%d", fc.Insertions, fc.Deletions)
+		}
+		lines[i] = fmt.Sprintf("        %s %s %s", st.stat.Render(branch), fc.Path, st.stat.Render(churn))
+	}
+	return lines
+}
+
+// rowHeight는 목록 줄 하나가 차지하는 화면 줄 수이다.
+func (m model) rowHeight(r row) int {
+	if r.commit < 0 {
+		return 1
+	}
+	repo := m.report.Results[r.repo]
+	c := repo.Commits[r.commit]
+	if !m.expanded[commitKey(repo.Path, c.Hash)] {
+		return 1
+	}
+	return 1 + max(len(c.Changes), 1)
+}
+
+// summaryLines는 AI 요약 패널이다. 화면의 절반을 넘지 않게 자른다.
+func (m model) summaryLines() []string {
+	if !m.showSummary || m.summary == "" {
+		return nil
+	}
+	st := m.styles
+	lines := []string{st.filter.Render(strings.Repeat("─", max(m.width, 1)))}
+	for _, line := range strings.Split(ansi.Wrap(m.summary, max(m.width, 1), ""), "\n") {
+		lines = append(lines, st.summary.Render(line))
+	}
+	if limit := max(m.height/2, 2); len(lines) > limit {
+		lines = lines[:limit]
+	}
+	return lines
+}
+
+// listHeight는 목록에 쓸 수 있는 화면 줄 수이다.
+func (m model) listHeight() int {
+	return max(m.height-headerLines-footerLines-len(m.summaryLines()), 1)
+}
+
+// scrollToCursor는 커서 줄(펼친 파일 목록 포함)이 화면 안에 들어오도록 offset을 맞춘다.
+func (m *model) scrollToCursor() {
+	rows := m.rows()
+	if m.cursor >= len(rows) {
+		m.offset = 0
+		return
+	}
+	start := 0
+	for _, r := range rows[:m.cursor] {
+		start += m.rowHeight(r)
+	}
+	end := start + m.rowHeight(rows[m.cursor])
+
+	height := m.listHeight()
+	if end > m.offset+height {
+		m.offset = end - height
+	}
+	if start < m.offset || end-start > height {
+		m.offset = start
+	}
+}
+
+func (m model) statusLine() string {
+	st := m.styles
+	switch {
+	case m.input != inputNone:
+		return m.inputPrompt() + string(m.inputValue) + "█"
+	case m.err != nil:
+		return st.errorMsg.Render(i18n.T("tui.error", m.err))
+	case m.loading:
+		return i18n.T("tui.loading")
+	case m.summarizing:
+		return i18n.T("tui.summarizing")
+	case m.status != "":
+		return i18n.T("tui.exported", m.status)
+	case len(m.report.Failed) > 0:
+		return st.errorMsg.Render(i18n.T("report.warnings", len(m.report.Failed)))
+	}
+	return ""
+}
+
+func (m model) inputPrompt() string {
+	switch m.input {
+	case inputPeriod:
+		return i18n.T("tui.prompt_period")
+	case inputAuthor:
+		return i18n.T("tui.prompt_author")
+	case inputExport:
+		return i18n.T("tui.prompt_export")
+	}
+	return ""
+}
+
+// totals는 결과 전체의 커밋/파일/추가/삭제 합계이다.
+func totals(results []git.RepoResult) (commits, files, insertions, deletions int) {
+	for _, r := range results {
+		commits += len(r.Commits)
+		for _, c := range r.Commits {
+			files += c.Files
+			insertions += c.Insertions
+			deletions += c.Deletions
+		}
+	}
+	return commits, files, insertions, deletions
+}
+
+func padRight(s string, width int) string {
+	if w := ansi.StringWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}